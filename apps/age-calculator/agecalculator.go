@@ -0,0 +1,83 @@
+// Package agecalculator computes a person's age in calendar years,
+// months, and days, and counts down to their next birthday.
+package agecalculator
+
+import (
+	"fmt"
+	"time"
+)
+
+// FutureBirthError is returned when birth is after now, so no age can
+// be computed.
+type FutureBirthError struct {
+	Birth time.Time
+	Now   time.Time
+}
+
+func (e *FutureBirthError) Error() string {
+	return fmt.Sprintf("agecalculator: birth date %s is after %s", e.Birth, e.Now)
+}
+
+// Age returns the calendar age between birth and now as years, months,
+// and days, e.g. someone born 2000-03-15 as of 2026-01-10 is 25 years,
+// 9 months, and 26 days old. Each component is the remainder after the
+// larger ones are removed, borrowing from the next larger unit when a
+// component would otherwise be negative, the same way people count
+// age by hand. now is read in birth's Location.
+func Age(birth, now time.Time) (years, months, days int, err error) {
+	if birth.After(now) {
+		return 0, 0, 0, &FutureBirthError{Birth: birth, Now: now}
+	}
+	now = now.In(birth.Location())
+
+	years = now.Year() - birth.Year()
+	months = int(now.Month()) - int(birth.Month())
+	days = now.Day() - birth.Day()
+
+	if days < 0 {
+		months--
+		// Borrow days from the month before now's current month.
+		prevMonth := time.Date(now.Year(), now.Month(), 1, 0, 0, 0, 0, now.Location()).AddDate(0, 0, -1)
+		days += prevMonth.Day()
+	}
+	if months < 0 {
+		years--
+		months += 12
+	}
+
+	return years, months, days, nil
+}
+
+// DaysUntilBirthday returns the number of days from now until birth's
+// next anniversary, 0 if today is the anniversary. A birth date of
+// February 29 counts March 1 as its anniversary in years that aren't
+// leap years. now is read in birth's Location.
+func DaysUntilBirthday(birth, now time.Time) int {
+	now = now.In(birth.Location())
+	today := truncateToDate(now)
+
+	next := nextBirthday(birth, now.Year())
+	if next.Before(today) {
+		next = nextBirthday(birth, now.Year()+1)
+	}
+
+	return int(next.Sub(today) / (24 * time.Hour))
+}
+
+// nextBirthday returns birth's anniversary date in year, falling back
+// to March 1 for a February 29 birthday in a non-leap year.
+func nextBirthday(birth time.Time, year int) time.Time {
+	month, day := birth.Month(), birth.Day()
+	if month == time.February && day == 29 && !isLeapYear(year) {
+		month, day = time.March, 1
+	}
+	return time.Date(year, month, day, 0, 0, 0, 0, birth.Location())
+}
+
+func isLeapYear(year int) bool {
+	return year%4 == 0 && (year%100 != 0 || year%400 == 0)
+}
+
+func truncateToDate(t time.Time) time.Time {
+	return time.Date(t.Year(), t.Month(), t.Day(), 0, 0, 0, 0, t.Location())
+}
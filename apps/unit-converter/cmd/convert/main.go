@@ -0,0 +1,186 @@
+// Command convert is a CLI wrapper around the converter package. It
+// exposes the library's registry as subcommands:
+//
+//	convert length --from m --to ft 1.0
+//	convert temp --from c --to f 100
+//	convert list
+//
+// A value can also be piped in on stdin instead of passed as an
+// argument, so the tool composes in shell pipelines.
+package main
+
+import (
+	"bufio"
+	"flag"
+	"fmt"
+	"os"
+	"sort"
+	"strconv"
+	"strings"
+
+	converter "github.com/richoandika/community-toolbox/apps/unit-converter"
+)
+
+// Exit codes let scripts branch on why a conversion failed.
+const (
+	exitOK = iota
+	exitUsage
+	exitUnknownUnit
+	exitDomainError
+)
+
+func main() {
+	os.Exit(run(os.Args[1:]))
+}
+
+func run(args []string) int {
+	if len(args) == 0 {
+		printUsage()
+		return exitUsage
+	}
+
+	switch args[0] {
+	case "list":
+		return runList(args[1:])
+	case "length", "temp":
+		return runConvert(args[0], args[1:])
+	case "-h", "--help", "help":
+		printUsage()
+		return exitOK
+	default:
+		fmt.Fprintf(os.Stderr, "convert: unknown command %q\n", args[0])
+		printUsage()
+		return exitUsage
+	}
+}
+
+func runConvert(command string, args []string) int {
+	from, to, precision, positional, err := parseConvertArgs(args)
+	if err != nil {
+		fmt.Fprintf(os.Stderr, "convert: %v\n", err)
+		return exitUsage
+	}
+	if from == "" || to == "" {
+		fmt.Fprintln(os.Stderr, "convert: --from and --to are required")
+		return exitUsage
+	}
+
+	value, err := readValue(positional)
+	if err != nil {
+		fmt.Fprintf(os.Stderr, "convert: %v\n", err)
+		return exitUsage
+	}
+
+	result, err := converter.ConvertUnits(from, to, value)
+	if err != nil {
+		fmt.Fprintf(os.Stderr, "convert: %v\n", err)
+		return exitCodeFor(err)
+	}
+
+	fmt.Printf("%.*f\n", precision, result)
+	return exitOK
+}
+
+// parseConvertArgs parses --from, --to and --precision out of args and
+// returns whatever is left as positional arguments. It is hand-rolled
+// rather than built on the flag package because flag.Parse treats any
+// remaining argument starting with "-" as an unknown flag, which would
+// reject the negative values (e.g. "-300" for a below-freezing
+// temperature) that this command needs to accept as the value operand.
+func parseConvertArgs(args []string) (from, to string, precision int, positional []string, err error) {
+	precision = 5
+	for i := 0; i < len(args); i++ {
+		switch args[i] {
+		case "--from":
+			i++
+			if i >= len(args) {
+				return "", "", 0, nil, fmt.Errorf("--from requires a value")
+			}
+			from = args[i]
+		case "--to":
+			i++
+			if i >= len(args) {
+				return "", "", 0, nil, fmt.Errorf("--to requires a value")
+			}
+			to = args[i]
+		case "--precision":
+			i++
+			if i >= len(args) {
+				return "", "", 0, nil, fmt.Errorf("--precision requires a value")
+			}
+			precision, err = strconv.Atoi(args[i])
+			if err != nil {
+				return "", "", 0, nil, fmt.Errorf("invalid --precision %q: %w", args[i], err)
+			}
+			if precision < 0 {
+				return "", "", 0, nil, fmt.Errorf("--precision must not be negative, got %d", precision)
+			}
+		default:
+			positional = append(positional, args[i])
+		}
+	}
+	return from, to, precision, positional, nil
+}
+
+func runList(args []string) int {
+	fs := flag.NewFlagSet("list", flag.ContinueOnError)
+	if err := fs.Parse(args); err != nil {
+		return exitUsage
+	}
+
+	byDimension := make(map[converter.Dimension][]string)
+	for _, unit := range converter.Units() {
+		byDimension[unit.Dimension] = append(byDimension[unit.Dimension], unit.Name)
+	}
+
+	dimensions := make([]string, 0, len(byDimension))
+	for dim := range byDimension {
+		dimensions = append(dimensions, string(dim))
+	}
+	sort.Strings(dimensions)
+
+	for _, dim := range dimensions {
+		names := byDimension[converter.Dimension(dim)]
+		sort.Strings(names)
+		fmt.Printf("%s: %s\n", dim, strings.Join(names, ", "))
+	}
+	return exitOK
+}
+
+// readValue returns the value to convert, either from the first
+// positional argument or, if there are none, from stdin so the command
+// can be used in a pipeline.
+func readValue(positional []string) (float64, error) {
+	if len(positional) > 0 {
+		return strconv.ParseFloat(positional[0], 64)
+	}
+	scanner := bufio.NewScanner(os.Stdin)
+	if !scanner.Scan() {
+		return 0, fmt.Errorf("no value given as an argument or on stdin")
+	}
+	return strconv.ParseFloat(strings.TrimSpace(scanner.Text()), 64)
+}
+
+// exitCodeFor classifies a converter error into an exit code so scripts
+// can distinguish an unknown unit from a valid-but-out-of-range one.
+func exitCodeFor(err error) int {
+	switch err.(type) {
+	case *converter.UnknownUnitError:
+		return exitUnknownUnit
+	case *converter.CrossDimensionError, *converter.ErrBelowAbsoluteZero:
+		return exitDomainError
+	default:
+		return exitUsage
+	}
+}
+
+func printUsage() {
+	fmt.Fprintln(os.Stderr, `usage: convert <command> [flags] [value]
+
+commands:
+  length --from UNIT --to UNIT [value]   convert a length
+  temp   --from UNIT --to UNIT [value]   convert a temperature
+  list                                   list registered units by dimension
+
+If value is omitted it is read from stdin.`)
+}
@@ -0,0 +1,100 @@
+package agecalculator
+
+import (
+	"errors"
+	"testing"
+	"time"
+)
+
+func TestAge(t *testing.T) {
+	birth := time.Date(2000, 3, 15, 0, 0, 0, 0, time.UTC)
+	now := time.Date(2026, 1, 10, 0, 0, 0, 0, time.UTC)
+
+	years, months, days, err := Age(birth, now)
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if years != 25 || months != 9 || days != 26 {
+		t.Fatalf("Age(birth, now) = (%d, %d, %d), want (25, 9, 26)", years, months, days)
+	}
+}
+
+func TestAgeOnExactBirthday(t *testing.T) {
+	birth := time.Date(2000, 3, 15, 0, 0, 0, 0, time.UTC)
+	now := time.Date(2026, 3, 15, 0, 0, 0, 0, time.UTC)
+
+	years, months, days, err := Age(birth, now)
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if years != 26 || months != 0 || days != 0 {
+		t.Fatalf("Age(birth, now) = (%d, %d, %d), want (26, 0, 0)", years, months, days)
+	}
+}
+
+func TestAgeMonthBoundaryBorrow(t *testing.T) {
+	// now's day-of-month is before birth's, so days must borrow from
+	// the month preceding now's current month.
+	birth := time.Date(2000, 1, 20, 0, 0, 0, 0, time.UTC)
+	now := time.Date(2000, 2, 5, 0, 0, 0, 0, time.UTC)
+
+	years, months, days, err := Age(birth, now)
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if years != 0 || months != 0 || days != 16 {
+		t.Fatalf("Age(birth, now) = (%d, %d, %d), want (0, 0, 16)", years, months, days)
+	}
+}
+
+func TestAgeFutureBirth(t *testing.T) {
+	birth := time.Date(2030, 1, 1, 0, 0, 0, 0, time.UTC)
+	now := time.Date(2026, 1, 1, 0, 0, 0, 0, time.UTC)
+
+	var target *FutureBirthError
+	if _, _, _, err := Age(birth, now); !errors.As(err, &target) {
+		t.Fatalf("expected *FutureBirthError, got %v", err)
+	}
+}
+
+func TestDaysUntilBirthday(t *testing.T) {
+	birth := time.Date(2000, 3, 15, 0, 0, 0, 0, time.UTC)
+	now := time.Date(2026, 3, 1, 0, 0, 0, 0, time.UTC)
+
+	got := DaysUntilBirthday(birth, now)
+	if got != 14 {
+		t.Fatalf("DaysUntilBirthday = %d, want 14", got)
+	}
+}
+
+func TestDaysUntilBirthdayToday(t *testing.T) {
+	birth := time.Date(2000, 3, 15, 0, 0, 0, 0, time.UTC)
+	now := time.Date(2026, 3, 15, 0, 0, 0, 0, time.UTC)
+
+	got := DaysUntilBirthday(birth, now)
+	if got != 0 {
+		t.Fatalf("DaysUntilBirthday = %d, want 0", got)
+	}
+}
+
+func TestDaysUntilBirthdayWrapsToNextYear(t *testing.T) {
+	birth := time.Date(2000, 1, 5, 0, 0, 0, 0, time.UTC)
+	now := time.Date(2026, 2, 1, 0, 0, 0, 0, time.UTC)
+
+	got := DaysUntilBirthday(birth, now)
+	want := int(time.Date(2027, 1, 5, 0, 0, 0, 0, time.UTC).Sub(time.Date(2026, 2, 1, 0, 0, 0, 0, time.UTC)) / (24 * time.Hour))
+	if got != want {
+		t.Fatalf("DaysUntilBirthday = %d, want %d", got, want)
+	}
+}
+
+func TestDaysUntilBirthdayLeapDayInNonLeapYear(t *testing.T) {
+	birth := time.Date(2000, 2, 29, 0, 0, 0, 0, time.UTC)
+	now := time.Date(2026, 2, 1, 0, 0, 0, 0, time.UTC)
+
+	got := DaysUntilBirthday(birth, now)
+	want := int(time.Date(2026, 3, 1, 0, 0, 0, 0, time.UTC).Sub(now) / (24 * time.Hour))
+	if got != want {
+		t.Fatalf("DaysUntilBirthday = %d, want %d", got, want)
+	}
+}
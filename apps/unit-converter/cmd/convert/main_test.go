@@ -0,0 +1,89 @@
+package main
+
+import (
+	"os"
+	"testing"
+)
+
+// runQuiet calls run(args) with stdout and stderr redirected to
+// /dev/null so test output isn't cluttered with the command's own
+// printing, and returns the exit code.
+func runQuiet(t *testing.T, args []string) int {
+	t.Helper()
+
+	devNull, err := os.OpenFile(os.DevNull, os.O_WRONLY, 0)
+	if err != nil {
+		t.Fatalf("opening %s: %v", os.DevNull, err)
+	}
+	defer devNull.Close()
+
+	stdout, stderr := os.Stdout, os.Stderr
+	os.Stdout, os.Stderr = devNull, devNull
+	defer func() { os.Stdout, os.Stderr = stdout, stderr }()
+
+	return run(args)
+}
+
+func TestRunExitCodes(t *testing.T) {
+	tests := []struct {
+		name string
+		args []string
+		want int
+	}{
+		{"ok length conversion", []string{"length", "--from", "m", "--to", "ft", "1"}, exitOK},
+		{"ok list", []string{"list"}, exitOK},
+		{"usage with no command", nil, exitUsage},
+		{"usage with unknown command", []string{"bogus"}, exitUsage},
+		{"usage with missing --from/--to", []string{"length", "--to", "ft", "1"}, exitUsage},
+		{"usage with negative precision", []string{"length", "--from", "m", "--to", "ft", "--precision", "-2", "1"}, exitUsage},
+		{"unknown unit", []string{"length", "--from", "parsecs", "--to", "m", "1"}, exitUnknownUnit},
+		{"domain error below absolute zero", []string{"temp", "--from", "c", "--to", "f", "-300"}, exitDomainError},
+		{"domain error cross dimension", []string{"length", "--from", "m", "--to", "c", "1"}, exitDomainError},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			got := runQuiet(t, tc.args)
+			if got != tc.want {
+				t.Fatalf("run(%v) = %d, want %d", tc.args, got, tc.want)
+			}
+		})
+	}
+}
+
+func TestParseConvertArgs(t *testing.T) {
+	from, to, precision, positional, err := parseConvertArgs([]string{"--from", "m", "--to", "ft", "1.5"})
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if from != "m" || to != "ft" || precision != 5 {
+		t.Fatalf("got from=%q to=%q precision=%d, want from=m to=ft precision=5", from, to, precision)
+	}
+	if len(positional) != 1 || positional[0] != "1.5" {
+		t.Fatalf("got positional=%v, want [1.5]", positional)
+	}
+}
+
+func TestParseConvertArgsNegativeValue(t *testing.T) {
+	_, _, _, positional, err := parseConvertArgs([]string{"--from", "c", "--to", "f", "-300"})
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if len(positional) != 1 || positional[0] != "-300" {
+		t.Fatalf("got positional=%v, want [-300]", positional)
+	}
+}
+
+func TestParseConvertArgsNegativePrecision(t *testing.T) {
+	_, _, _, _, err := parseConvertArgs([]string{"--from", "m", "--to", "ft", "--precision", "-2"})
+	if err == nil {
+		t.Fatal("expected error for negative --precision")
+	}
+}
+
+func TestParseConvertArgsMissingFlagValue(t *testing.T) {
+	_, _, _, _, err := parseConvertArgs([]string{"--from"})
+	if err == nil {
+		t.Fatal("expected error when --from has no value")
+	}
+}
@@ -0,0 +1,53 @@
+// Package baseconverter converts integers between numeral bases 2
+// through 36, rendering digits 10-35 as lowercase a-z (the same
+// alphabet strconv uses), so "ff" and "FF" are both valid base-16
+// input.
+package baseconverter
+
+import (
+	"fmt"
+	"math/big"
+	"strings"
+)
+
+// InvalidBaseError is returned when fromBase or toBase falls outside
+// the supported range of 2 to 36.
+type InvalidBaseError struct {
+	Base int
+}
+
+func (e *InvalidBaseError) Error() string {
+	return fmt.Sprintf("baseconverter: base %d is outside the supported range 2-36", e.Base)
+}
+
+// InvalidDigitError is returned when value contains a character that
+// is not a valid digit in fromBase.
+type InvalidDigitError struct {
+	Value string
+	Base  int
+}
+
+func (e *InvalidDigitError) Error() string {
+	return fmt.Sprintf("baseconverter: %q is not a valid base-%d number", e.Value, e.Base)
+}
+
+// Convert parses value as an integer in fromBase and renders it in
+// toBase, e.g. Convert("FF", 16, 10) returns "255". value may carry a
+// leading "-" for negative numbers; it is otherwise interpreted
+// case-insensitively, matching strconv's base-36 digit alphabet.
+// Convert mirrors the unit-converter package's Convert signature for
+// consistency across the toolbox.
+func Convert(value string, fromBase, toBase int) (string, error) {
+	if fromBase < 2 || fromBase > 36 {
+		return "", &InvalidBaseError{Base: fromBase}
+	}
+	if toBase < 2 || toBase > 36 {
+		return "", &InvalidBaseError{Base: toBase}
+	}
+
+	n := new(big.Int)
+	if _, ok := n.SetString(strings.TrimSpace(value), fromBase); !ok {
+		return "", &InvalidDigitError{Value: value, Base: fromBase}
+	}
+	return n.Text(toBase), nil
+}
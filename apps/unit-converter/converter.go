@@ -1,29 +1,51 @@
+// Package converter provides unit conversion built on a pluggable
+// Registry: each dimension (length, temperature, ...) has a base unit,
+// and every registered unit only needs to know how to convert to and
+// from that base, so adding a unit never requires touching Convert.
 package converter
 
-import "errors"
+import (
+	"fmt"
+	"strings"
+)
 
 const (
 	metersToFeetFactor = 3.28084
 	feetToMetersFactor = 0.3048
 )
 
-// Convert applies the given conversion keyword to the value.
-// Supported conversions:
-//   - "m2ft": meters to feet
-//   - "ft2m": feet to meters
-//   - "c2f": Celsius to Fahrenheit
-//   - "f2c": Fahrenheit to Celsius
+// Convert applies a legacy "from2to" conversion keyword to value, e.g.
+// "m2ft" or "c2f". It is a thin wrapper around ConvertUnits kept for
+// backward compatibility; new code should prefer ConvertUnits or
+// Register directly against a Registry.
 func Convert(kind string, value float64) (float64, error) {
-	switch kind {
-	case "m2ft":
-		return value * metersToFeetFactor, nil
-	case "ft2m":
-		return value * feetToMetersFactor, nil
-	case "c2f":
-		return value*9.0/5.0 + 32, nil
-	case "f2c":
-		return (value - 32) * 5.0 / 9.0, nil
-	default:
-		return 0, errors.New("unsupported conversion")
+	idx := strings.Index(kind, "2")
+	if idx <= 0 || idx >= len(kind)-1 {
+		return 0, fmt.Errorf("converter: invalid conversion keyword %q", kind)
 	}
+	return ConvertUnits(kind[:idx], kind[idx+1:], value)
+}
+
+// ConvertUnits converts v from unit name "from" to unit name "to" using
+// the package's default registry. See Registry.ConvertUnits.
+func ConvertUnits(from, to string, v float64) (float64, error) {
+	return defaultRegistry.ConvertUnits(from, to, v)
+}
+
+// Register adds a unit to the package's default registry. See
+// Registry.Register.
+func Register(unit Unit, toBase, fromBase func(float64) float64) error {
+	return defaultRegistry.Register(unit, toBase, fromBase)
+}
+
+// Alias registers alternate names for a unit already in the package's
+// default registry. See Registry.Alias.
+func Alias(canonical string, names ...string) error {
+	return defaultRegistry.Alias(canonical, names...)
+}
+
+// Units returns every unit registered in the package's default
+// registry. See Registry.Units.
+func Units() []Unit {
+	return defaultRegistry.Units()
 }
@@ -0,0 +1,91 @@
+package baseconverter
+
+import "testing"
+
+func TestConvert(t *testing.T) {
+	tests := []struct {
+		name     string
+		value    string
+		fromBase int
+		toBase   int
+		want     string
+	}{
+		{"hex to dec", "FF", 16, 10, "255"},
+		{"dec to hex", "255", 10, 16, "ff"},
+		{"dec to bin", "10", 10, 2, "1010"},
+		{"bin to dec", "1010", 2, 10, "10"},
+		{"dec to octal", "8", 10, 8, "10"},
+		{"octal to dec", "10", 8, 10, "8"},
+		{"negative number", "-10", 10, 2, "-1010"},
+		{"lowercase hex input", "ff", 16, 10, "255"},
+		{"base 36", "Z", 36, 10, "35"},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			got, err := Convert(tc.value, tc.fromBase, tc.toBase)
+			if err != nil {
+				t.Fatalf("unexpected error: %v", err)
+			}
+			if got != tc.want {
+				t.Fatalf("Convert(%q, %d, %d) = %q, want %q", tc.value, tc.fromBase, tc.toBase, got, tc.want)
+			}
+		})
+	}
+}
+
+func TestConvertRoundTrip(t *testing.T) {
+	bases := []int{2, 8, 10, 16, 36}
+	for _, from := range bases {
+		for _, to := range bases {
+			original := "123456789"
+			mid, err := Convert(original, 10, from)
+			if err != nil {
+				t.Fatalf("Convert(%q, 10, %d): %v", original, from, err)
+			}
+			roundTrip, err := Convert(mid, from, to)
+			if err != nil {
+				t.Fatalf("Convert(%q, %d, %d): %v", mid, from, to, err)
+			}
+			back, err := Convert(roundTrip, to, 10)
+			if err != nil {
+				t.Fatalf("Convert(%q, %d, 10): %v", roundTrip, to, err)
+			}
+			if back != original {
+				t.Fatalf("round trip through bases %d->%d = %q, want %q", from, to, back, original)
+			}
+		}
+	}
+}
+
+func TestConvertInvalidDigit(t *testing.T) {
+	_, err := Convert("12Z", 10, 16)
+	if err == nil {
+		t.Fatal("expected error for invalid digit")
+	}
+	if _, ok := err.(*InvalidDigitError); !ok {
+		t.Fatalf("expected *InvalidDigitError, got %T", err)
+	}
+}
+
+func TestConvertInvalidBase(t *testing.T) {
+	tests := []struct {
+		name     string
+		fromBase int
+		toBase   int
+	}{
+		{"fromBase too low", 1, 10},
+		{"fromBase too high", 37, 10},
+		{"toBase too low", 10, 1},
+		{"toBase too high", 10, 37},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			_, err := Convert("10", tc.fromBase, tc.toBase)
+			if err == nil {
+				t.Fatal("expected error for out-of-range base")
+			}
+		})
+	}
+}
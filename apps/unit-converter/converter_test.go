@@ -1,6 +1,27 @@
 package converter
 
-import "testing"
+import (
+	"math"
+	"testing"
+)
+
+// assertClose reports a test failure unless got and want agree within
+// relTol relative tolerance or absTol absolute tolerance, whichever is
+// looser. It falls back to absTol alone when want is zero, where a
+// relative tolerance is meaningless. This replaces the ad-hoc
+// `diff > 1e-5 || diff < -1e-5` checks that hid precision regressions
+// on low-magnitude results, like micrometer conversions.
+func assertClose(t *testing.T, got, want, relTol, absTol float64) {
+	t.Helper()
+	diff := math.Abs(got - want)
+	if diff <= absTol {
+		return
+	}
+	if want != 0 && diff/math.Abs(want) <= relTol {
+		return
+	}
+	t.Fatalf("got %v, want %v (diff %v exceeds relTol=%v, absTol=%v)", got, want, diff, relTol, absTol)
+}
 
 func TestConvertLength(t *testing.T) {
 	tests := []struct {
@@ -19,9 +40,7 @@ func TestConvertLength(t *testing.T) {
 			if err != nil {
 				t.Fatalf("unexpected error: %v", err)
 			}
-			if diff := got - tc.expected; diff > 1e-5 || diff < -1e-5 {
-				t.Fatalf("expected %.5f, got %.5f", tc.expected, got)
-			}
+			assertClose(t, got, tc.expected, 1e-5, 1e-5)
 		})
 	}
 }
@@ -35,6 +54,12 @@ func TestConvertTemperature(t *testing.T) {
 	}{
 		{"celsius to fahrenheit", "c2f", 0, 32},
 		{"fahrenheit to celsius", "f2c", 212, 100},
+		{"celsius to kelvin", "c2k", 0, 273.15},
+		{"kelvin to celsius", "k2c", 273.15, 0},
+		{"celsius to rankine", "c2r", 0, 491.67},
+		{"celsius to reaumur", "c2re", 100, 80},
+		{"celsius to delisle", "c2de", 100, 0},
+		{"celsius to delisle, freezing", "c2de", 0, 150},
 	}
 
 	for _, tc := range tests {
@@ -43,9 +68,7 @@ func TestConvertTemperature(t *testing.T) {
 			if err != nil {
 				t.Fatalf("unexpected error: %v", err)
 			}
-			if diff := got - tc.expected; diff > 1e-5 || diff < -1e-5 {
-				t.Fatalf("expected %.2f, got %.2f", tc.expected, got)
-			}
+			assertClose(t, got, tc.expected, 1e-5, 1e-5)
 		})
 	}
 }
@@ -55,3 +78,160 @@ func TestConvertInvalid(t *testing.T) {
 		t.Fatal("expected error for invalid conversion")
 	}
 }
+
+func TestConvertUnitsAliasesAndCase(t *testing.T) {
+	tests := []struct {
+		from, to string
+		value    float64
+		expected float64
+	}{
+		{"METERS", "Feet", 1, 3.28084},
+		{"°C", "°F", 0, 32},
+		{"celsius", "fahrenheit", 100, 212},
+	}
+	for _, tc := range tests {
+		got, err := ConvertUnits(tc.from, tc.to, tc.value)
+		if err != nil {
+			t.Fatalf("ConvertUnits(%q, %q, %v): unexpected error: %v", tc.from, tc.to, tc.value, err)
+		}
+		assertClose(t, got, tc.expected, 1e-5, 1e-5)
+	}
+}
+
+func TestConvertSIPrefixes(t *testing.T) {
+	tests := []struct {
+		from, to string
+		value    float64
+		expected float64
+	}{
+		{"km", "m", 1, 1000},
+		{"hm", "m", 10, 1000},
+		{"dam", "m", 100, 1000},
+		{"dm", "m", 10, 1},
+		{"cm", "m", 100, 1},
+		{"mm", "m", 1000, 1},
+		{"nm", "m", 1e9, 1},
+		{"µm", "m", 1e6, 1},
+	}
+	for _, tc := range tests {
+		got, err := ConvertUnits(tc.from, tc.to, tc.value)
+		if err != nil {
+			t.Fatalf("ConvertUnits(%q, %q, %v): unexpected error: %v", tc.from, tc.to, tc.value, err)
+		}
+		assertClose(t, got, tc.expected, 1e-9, 1e-9)
+	}
+}
+
+func TestConvertCrossDimension(t *testing.T) {
+	_, err := ConvertUnits("m", "c", 1)
+	if err == nil {
+		t.Fatal("expected error converting meters to Celsius")
+	}
+	if _, ok := err.(*CrossDimensionError); !ok {
+		t.Fatalf("expected *CrossDimensionError, got %T", err)
+	}
+}
+
+func TestConvertUnknownUnit(t *testing.T) {
+	_, err := ConvertUnits("parsecs", "m", 1)
+	if err == nil {
+		t.Fatal("expected error for unknown unit")
+	}
+	if _, ok := err.(*UnknownUnitError); !ok {
+		t.Fatalf("expected *UnknownUnitError, got %T", err)
+	}
+}
+
+func TestConvertBelowAbsoluteZero(t *testing.T) {
+	tests := []struct {
+		name string
+		from string
+		to   string
+		v    float64
+	}{
+		{"celsius below absolute zero", "c", "f", -300},
+		{"fahrenheit below absolute zero", "f", "c", -500},
+		{"kelvin below absolute zero", "k", "c", -1},
+		{"delisle below absolute zero (above its own max)", "de", "c", 600},
+	}
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			_, err := ConvertUnits(tc.from, tc.to, tc.v)
+			if err == nil {
+				t.Fatal("expected error for value below absolute zero")
+			}
+			if _, ok := err.(*ErrBelowAbsoluteZero); !ok {
+				t.Fatalf("expected *ErrBelowAbsoluteZero, got %T", err)
+			}
+		})
+	}
+}
+
+// TestConvertRoundTrip checks that converting a unit to every other
+// unit in its dimension and back recovers the original value, across a
+// range of boundary values (zero, negative, very large, very small).
+func TestConvertRoundTrip(t *testing.T) {
+	dimensions := map[Dimension][]string{
+		DimensionLength:      {"m", "ft", "km", "cm", "mm"},
+		DimensionTemperature: {"c", "f", "k", "r", "re", "de"},
+	}
+	boundaryValues := []float64{0, 1, -1, 1e6, 1e-6}
+
+	for dim, units := range dimensions {
+		base := "m"
+		if dim == DimensionTemperature {
+			base = "k"
+		}
+		for _, unit := range units {
+			for _, v := range boundaryValues {
+				name := string(dim) + "/" + unit
+				t.Run(name, func(t *testing.T) {
+					inBase, err := ConvertUnits(unit, base, v)
+					if err != nil {
+						// A boundary value may be below this unit's
+						// absolute zero; that's the validation working
+						// as intended, not a round-trip failure.
+						return
+					}
+					back, err := ConvertUnits(base, unit, inBase)
+					if err != nil {
+						t.Fatalf("unexpected error converting back: %v", err)
+					}
+					assertClose(t, back, v, 1e-6, 1e-6)
+				})
+			}
+		}
+	}
+}
+
+func TestConvertExpr(t *testing.T) {
+	tests := []struct {
+		name     string
+		expr     string
+		value    float64
+		expected float64
+	}{
+		{"km/h to m/s", "km/h -> m/s", 36, 10},
+		{"N*m to J", "N*m -> J", 2, 2},
+		{"kg*m^2/s^2 to J", "kg*m^2/s^2 -> J", 3, 3},
+	}
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			got, _, err := ConvertExpr(tc.expr, tc.value)
+			if err != nil {
+				t.Fatalf("unexpected error: %v", err)
+			}
+			assertClose(t, got, tc.expected, 1e-9, 1e-9)
+		})
+	}
+}
+
+func TestConvertExprDimensionMismatch(t *testing.T) {
+	_, _, err := ConvertExpr("m -> s", 1)
+	if err == nil {
+		t.Fatal("expected error converting length to time")
+	}
+	if _, ok := err.(*DimensionMismatchError); !ok {
+		t.Fatalf("expected *DimensionMismatchError, got %T", err)
+	}
+}
@@ -0,0 +1,50 @@
+// Package bmicalculator computes Body Mass Index and categorizes it
+// using the WHO's standard thresholds. BMI is only defined in metric
+// terms (kilograms and meters); to convert pounds or inches first, use
+// the unit-converter package, e.g.:
+//
+//	kg, _ := converter.Convert("lb2kg", weightLb)
+//	m, _ := converter.Convert("in2m", heightIn)
+//	bmi, _ := bmicalculator.BMI(kg, m)
+package bmicalculator
+
+import "fmt"
+
+// NonPositiveValueError is returned when BMI is given a zero or
+// negative weight or height, neither of which is physically possible.
+type NonPositiveValueError struct {
+	Field string
+	Value float64
+}
+
+func (e *NonPositiveValueError) Error() string {
+	return fmt.Sprintf("bmicalculator: %s must be positive, got %g", e.Field, e.Value)
+}
+
+// BMI computes Body Mass Index from weight in kilograms and height in
+// meters. Both must be positive.
+func BMI(weightKg, heightM float64) (float64, error) {
+	if weightKg <= 0 {
+		return 0, &NonPositiveValueError{Field: "weight", Value: weightKg}
+	}
+	if heightM <= 0 {
+		return 0, &NonPositiveValueError{Field: "height", Value: heightM}
+	}
+	return weightKg / (heightM * heightM), nil
+}
+
+// Category returns the WHO weight category for a BMI value:
+// Underweight (<18.5), Normal (18.5-24.9), Overweight (25-29.9), or
+// Obese (30+).
+func Category(bmi float64) string {
+	switch {
+	case bmi < 18.5:
+		return "Underweight"
+	case bmi < 25:
+		return "Normal"
+	case bmi < 30:
+		return "Overweight"
+	default:
+		return "Obese"
+	}
+}
@@ -0,0 +1,67 @@
+package bmicalculator
+
+import (
+	"math"
+	"testing"
+)
+
+func assertClose(t *testing.T, got, want, tol float64) {
+	t.Helper()
+	if math.Abs(got-want) > tol {
+		t.Fatalf("got %v, want %v", got, want)
+	}
+}
+
+func TestBMI(t *testing.T) {
+	got, err := BMI(70, 1.75)
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	assertClose(t, got, 22.86, 1e-2)
+}
+
+func TestBMINonPositive(t *testing.T) {
+	tests := []struct {
+		name     string
+		weightKg float64
+		heightM  float64
+	}{
+		{"zero weight", 0, 1.75},
+		{"negative weight", -10, 1.75},
+		{"zero height", 70, 0},
+		{"negative height", 70, -1.75},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			if _, err := BMI(tc.weightKg, tc.heightM); err == nil {
+				t.Fatal("expected error")
+			}
+		})
+	}
+}
+
+func TestCategory(t *testing.T) {
+	tests := []struct {
+		name string
+		bmi  float64
+		want string
+	}{
+		{"underweight", 18.4, "Underweight"},
+		{"normal lower boundary", 18.5, "Normal"},
+		{"normal upper boundary", 24.9, "Normal"},
+		{"overweight lower boundary", 25, "Overweight"},
+		{"overweight upper boundary", 29.9, "Overweight"},
+		{"obese boundary", 30, "Obese"},
+		{"obese", 35, "Obese"},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			got := Category(tc.bmi)
+			if got != tc.want {
+				t.Fatalf("Category(%v) = %q, want %q", tc.bmi, got, tc.want)
+			}
+		})
+	}
+}
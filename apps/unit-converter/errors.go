@@ -0,0 +1,45 @@
+package converter
+
+import "fmt"
+
+// UnknownUnitError is returned when a conversion references a unit name
+// that has not been registered.
+type UnknownUnitError struct {
+	Unit string
+}
+
+func (e *UnknownUnitError) Error() string {
+	return fmt.Sprintf("converter: unknown unit %q", e.Unit)
+}
+
+// DuplicateUnitError is returned by Register when a unit name has
+// already been registered.
+type DuplicateUnitError struct {
+	Unit string
+}
+
+func (e *DuplicateUnitError) Error() string {
+	return fmt.Sprintf("converter: unit %q is already registered", e.Unit)
+}
+
+// ErrBelowAbsoluteZero is returned when a temperature value is below
+// absolute zero in its own scale, e.g. a Celsius value under -273.15.
+type ErrBelowAbsoluteZero struct {
+	Unit  string
+	Value float64
+}
+
+func (e *ErrBelowAbsoluteZero) Error() string {
+	return fmt.Sprintf("converter: %g %s is below absolute zero", e.Value, e.Unit)
+}
+
+// CrossDimensionError is returned when a conversion is attempted between
+// units that belong to different dimensions (e.g. meters to Celsius).
+type CrossDimensionError struct {
+	From, To Unit
+}
+
+func (e *CrossDimensionError) Error() string {
+	return fmt.Sprintf("converter: cannot convert %s (%s) to %s (%s)",
+		e.From.Name, e.From.Dimension, e.To.Name, e.To.Dimension)
+}
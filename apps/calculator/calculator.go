@@ -0,0 +1,206 @@
+// Package calculator evaluates simple arithmetic expressions typed as
+// plain strings, supporting +, -, *, /, parentheses and unary minus
+// with standard operator precedence.
+package calculator
+
+import (
+	"fmt"
+	"strconv"
+	"unicode"
+)
+
+// ErrDivideByZero is returned by Eval when an expression divides by
+// zero.
+var ErrDivideByZero = fmt.Errorf("calculator: division by zero")
+
+// tokenKind identifies what a token represents.
+type tokenKind int
+
+const (
+	tokenNumber tokenKind = iota
+	tokenPlus
+	tokenMinus
+	tokenStar
+	tokenSlash
+	tokenLParen
+	tokenRParen
+)
+
+// token is one lexical unit of an expression.
+type token struct {
+	kind  tokenKind
+	value float64 // only meaningful when kind == tokenNumber
+}
+
+// tokenize splits expr into tokens, skipping whitespace. An unexpected
+// character returns an error naming it.
+func tokenize(expr string) ([]token, error) {
+	var tokens []token
+	runes := []rune(expr)
+	for i := 0; i < len(runes); {
+		r := runes[i]
+		switch {
+		case unicode.IsSpace(r):
+			i++
+		case r == '+':
+			tokens = append(tokens, token{kind: tokenPlus})
+			i++
+		case r == '-':
+			tokens = append(tokens, token{kind: tokenMinus})
+			i++
+		case r == '*':
+			tokens = append(tokens, token{kind: tokenStar})
+			i++
+		case r == '/':
+			tokens = append(tokens, token{kind: tokenSlash})
+			i++
+		case r == '(':
+			tokens = append(tokens, token{kind: tokenLParen})
+			i++
+		case r == ')':
+			tokens = append(tokens, token{kind: tokenRParen})
+			i++
+		case unicode.IsDigit(r) || r == '.':
+			start := i
+			for i < len(runes) && (unicode.IsDigit(runes[i]) || runes[i] == '.') {
+				i++
+			}
+			n, err := strconv.ParseFloat(string(runes[start:i]), 64)
+			if err != nil {
+				return nil, fmt.Errorf("calculator: %q is not a valid number", string(runes[start:i]))
+			}
+			tokens = append(tokens, token{kind: tokenNumber, value: n})
+		default:
+			return nil, fmt.Errorf("calculator: unexpected character %q", r)
+		}
+	}
+	return tokens, nil
+}
+
+// parser walks a token slice with a recursive-descent grammar that
+// mirrors standard arithmetic precedence: expr -> term (('+'|'-') term)*,
+// term -> unary (('*'|'/') unary)*, unary -> '-' unary | primary,
+// primary -> number | '(' expr ')'.
+type parser struct {
+	tokens []token
+	pos    int
+}
+
+func (p *parser) peek() (token, bool) {
+	if p.pos >= len(p.tokens) {
+		return token{}, false
+	}
+	return p.tokens[p.pos], true
+}
+
+func (p *parser) next() (token, bool) {
+	t, ok := p.peek()
+	if ok {
+		p.pos++
+	}
+	return t, ok
+}
+
+func (p *parser) parseExpr() (float64, error) {
+	left, err := p.parseTerm()
+	if err != nil {
+		return 0, err
+	}
+	for {
+		t, ok := p.peek()
+		if !ok || (t.kind != tokenPlus && t.kind != tokenMinus) {
+			return left, nil
+		}
+		p.next()
+		right, err := p.parseTerm()
+		if err != nil {
+			return 0, err
+		}
+		if t.kind == tokenPlus {
+			left += right
+		} else {
+			left -= right
+		}
+	}
+}
+
+func (p *parser) parseTerm() (float64, error) {
+	left, err := p.parseUnary()
+	if err != nil {
+		return 0, err
+	}
+	for {
+		t, ok := p.peek()
+		if !ok || (t.kind != tokenStar && t.kind != tokenSlash) {
+			return left, nil
+		}
+		p.next()
+		right, err := p.parseUnary()
+		if err != nil {
+			return 0, err
+		}
+		if t.kind == tokenStar {
+			left *= right
+		} else {
+			if right == 0 {
+				return 0, ErrDivideByZero
+			}
+			left /= right
+		}
+	}
+}
+
+func (p *parser) parseUnary() (float64, error) {
+	if t, ok := p.peek(); ok && t.kind == tokenMinus {
+		p.next()
+		v, err := p.parseUnary()
+		if err != nil {
+			return 0, err
+		}
+		return -v, nil
+	}
+	return p.parsePrimary()
+}
+
+func (p *parser) parsePrimary() (float64, error) {
+	t, ok := p.next()
+	if !ok {
+		return 0, fmt.Errorf("calculator: unexpected end of expression")
+	}
+	switch t.kind {
+	case tokenNumber:
+		return t.value, nil
+	case tokenLParen:
+		v, err := p.parseExpr()
+		if err != nil {
+			return 0, err
+		}
+		closing, ok := p.next()
+		if !ok || closing.kind != tokenRParen {
+			return 0, fmt.Errorf("calculator: missing closing parenthesis")
+		}
+		return v, nil
+	default:
+		return 0, fmt.Errorf("calculator: unexpected token in expression")
+	}
+}
+
+// Eval evaluates expr, a string of arithmetic combining +, -, *, /,
+// parentheses and unary minus with standard precedence (e.g. "2+3*4"
+// is 14, not 20). A malformed expression returns a parse error; a
+// division by zero returns ErrDivideByZero.
+func Eval(expr string) (float64, error) {
+	tokens, err := tokenize(expr)
+	if err != nil {
+		return 0, err
+	}
+	p := &parser{tokens: tokens}
+	result, err := p.parseExpr()
+	if err != nil {
+		return 0, err
+	}
+	if p.pos != len(p.tokens) {
+		return 0, fmt.Errorf("calculator: unexpected trailing input")
+	}
+	return result, nil
+}
@@ -0,0 +1,60 @@
+package calculator
+
+import (
+	"errors"
+	"math"
+	"testing"
+)
+
+func assertClose(t *testing.T, got, want float64) {
+	t.Helper()
+	if math.Abs(got-want) > 1e-9 {
+		t.Fatalf("got %v, want %v", got, want)
+	}
+}
+
+func TestEval(t *testing.T) {
+	tests := []struct {
+		expr string
+		want float64
+	}{
+		{"2+3*4", 14},
+		{"(2+3)*4", 20},
+		{"2*3+4", 10},
+		{"10/2-1", 4},
+		{"-5+3", -2},
+		{"3*-2", -6},
+		{"-(2+3)", -5},
+		{"1.5+2.5", 4},
+		{"2*(3+4*(1+1))", 22},
+		{"  2  +  3  ", 5},
+	}
+	for _, tc := range tests {
+		got, err := Eval(tc.expr)
+		if err != nil {
+			t.Fatalf("Eval(%q) returned unexpected error: %v", tc.expr, err)
+		}
+		assertClose(t, got, tc.want)
+	}
+}
+
+func TestEvalDivideByZero(t *testing.T) {
+	if _, err := Eval("1/0"); !errors.Is(err, ErrDivideByZero) {
+		t.Fatalf("expected ErrDivideByZero, got %v", err)
+	}
+}
+
+func TestEvalParseErrors(t *testing.T) {
+	tests := []string{
+		"1 + ",
+		"(1+2",
+		"1 2",
+		"1 + $",
+		"",
+	}
+	for _, expr := range tests {
+		if _, err := Eval(expr); err == nil {
+			t.Errorf("Eval(%q) expected an error, got none", expr)
+		}
+	}
+}
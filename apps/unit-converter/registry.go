@@ -0,0 +1,155 @@
+package converter
+
+import "strings"
+
+// Dimension identifies the physical quantity a Unit measures. Units can
+// only be converted to other units that share the same Dimension.
+type Dimension string
+
+// Dimensions supported by the registry. Not every dimension has units
+// registered yet; new units can be added for any of these without
+// touching Convert or ConvertUnits.
+const (
+	DimensionLength      Dimension = "length"
+	DimensionTemperature Dimension = "temperature"
+	DimensionMass        Dimension = "mass"
+	DimensionVolume      Dimension = "volume"
+	DimensionTime        Dimension = "time"
+	DimensionPressure    Dimension = "pressure"
+	DimensionSpeed       Dimension = "speed"
+	DimensionEnergy      Dimension = "energy"
+	DimensionData        Dimension = "data"
+)
+
+// Unit describes a single unit of measurement and the dimension it
+// belongs to.
+type Unit struct {
+	Name      string
+	Dimension Dimension
+}
+
+// Quantity pairs a value with the unit it is expressed in.
+type Quantity struct {
+	Value float64
+	Unit  Unit
+}
+
+// unitEntry is how the registry stores a unit alongside the functions
+// needed to move a value to and from the dimension's base unit.
+type unitEntry struct {
+	unit     Unit
+	toBase   func(float64) float64
+	fromBase func(float64) float64
+	validate func(float64) error
+}
+
+// Registry holds the set of units known to a converter, keyed by
+// lower-cased unit name. A Registry is safe to extend at any time via
+// Register, including by third-party packages.
+type Registry struct {
+	units   map[string]unitEntry
+	aliases map[string]string // normalized alias -> normalized canonical name
+}
+
+// NewRegistry returns an empty Registry.
+func NewRegistry() *Registry {
+	return &Registry{
+		units:   make(map[string]unitEntry),
+		aliases: make(map[string]string),
+	}
+}
+
+// Register adds a unit to the registry. toBase converts a value in unit
+// to the dimension's base unit; fromBase converts a base-unit value back
+// to unit. Registering a name that already exists returns an error.
+func (r *Registry) Register(unit Unit, toBase, fromBase func(float64) float64) error {
+	return r.RegisterChecked(unit, toBase, fromBase, nil)
+}
+
+// RegisterChecked is like Register but also attaches a validate function
+// that is run against the input value whenever the unit is used as the
+// source of a conversion; a non-nil error aborts the conversion before
+// toBase is applied. Pass a nil validate to skip the check.
+func (r *Registry) RegisterChecked(unit Unit, toBase, fromBase func(float64) float64, validate func(float64) error) error {
+	key := normalizeUnitName(unit.Name)
+	if _, exists := r.units[key]; exists {
+		return &DuplicateUnitError{Unit: unit.Name}
+	}
+	r.units[key] = unitEntry{unit: unit, toBase: toBase, fromBase: fromBase, validate: validate}
+	return nil
+}
+
+// Alias registers one or more alternate names for an already-registered
+// canonical unit, so that e.g. Alias("m", "meter", "meters", "metre")
+// lets callers spell out "meters" wherever "m" is accepted. Matching is
+// case-insensitive. Aliasing an unknown canonical unit, or a name that
+// is already a unit or alias, returns an error.
+func (r *Registry) Alias(canonical string, names ...string) error {
+	canonicalKey := normalizeUnitName(canonical)
+	if _, ok := r.units[canonicalKey]; !ok {
+		return &UnknownUnitError{Unit: canonical}
+	}
+	for _, name := range names {
+		key := normalizeUnitName(name)
+		if _, ok := r.units[key]; ok {
+			return &DuplicateUnitError{Unit: name}
+		}
+		if _, ok := r.aliases[key]; ok {
+			return &DuplicateUnitError{Unit: name}
+		}
+		r.aliases[key] = canonicalKey
+	}
+	return nil
+}
+
+func (r *Registry) lookup(name string) (unitEntry, error) {
+	key := normalizeUnitName(name)
+	if canonical, ok := r.aliases[key]; ok {
+		key = canonical
+	}
+	entry, ok := r.units[key]
+	if !ok {
+		return unitEntry{}, &UnknownUnitError{Unit: name}
+	}
+	return entry, nil
+}
+
+// ConvertUnits converts v from the from unit to the to unit. Both names
+// are matched case-insensitively against registered units. An
+// UnknownUnitError is returned if either unit is not registered, and a
+// CrossDimensionError is returned if the two units do not share a
+// dimension.
+func (r *Registry) ConvertUnits(from, to string, v float64) (float64, error) {
+	fromEntry, err := r.lookup(from)
+	if err != nil {
+		return 0, err
+	}
+	toEntry, err := r.lookup(to)
+	if err != nil {
+		return 0, err
+	}
+	if fromEntry.unit.Dimension != toEntry.unit.Dimension {
+		return 0, &CrossDimensionError{From: fromEntry.unit, To: toEntry.unit}
+	}
+	if fromEntry.validate != nil {
+		if err := fromEntry.validate(v); err != nil {
+			return 0, err
+		}
+	}
+	return toEntry.fromBase(fromEntry.toBase(v)), nil
+}
+
+func normalizeUnitName(name string) string {
+	return strings.ToLower(strings.TrimSpace(name))
+}
+
+// Units returns every unit registered directly (not as an alias), in no
+// particular order. It is primarily useful for listing what a Registry
+// supports, e.g. a CLI's "list" command.
+func (r *Registry) Units() []Unit {
+	units := make([]Unit, 0, len(r.units))
+	for _, entry := range r.units {
+		units = append(units, entry.unit)
+	}
+	return units
+}
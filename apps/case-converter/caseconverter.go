@@ -0,0 +1,119 @@
+// Package caseconverter normalizes identifiers and phrases between
+// camelCase, snake_case, kebab-case, PascalCase, and Title Case. Every
+// conversion first splits the input into words via splitWords, so all
+// five target cases agree on word boundaries regardless of the input
+// style.
+package caseconverter
+
+import (
+	"strings"
+	"unicode"
+)
+
+// splitWords breaks s into lowercase words, treating spaces, underscores,
+// and hyphens as explicit delimiters, and camelCase/PascalCase boundaries
+// (a lowercase-to-uppercase transition, or a run of uppercase letters
+// followed by a lowercase one, e.g. "URLParser" -> "url", "parser") as
+// implicit ones. Consecutive delimiters and leading/trailing delimiters
+// produce no empty words.
+func splitWords(s string) []string {
+	var words []string
+	var current []rune
+
+	flush := func() {
+		if len(current) > 0 {
+			words = append(words, strings.ToLower(string(current)))
+			current = nil
+		}
+	}
+
+	runes := []rune(s)
+	for i, r := range runes {
+		switch {
+		case r == ' ' || r == '_' || r == '-':
+			flush()
+		case i > 0 && unicode.IsUpper(r) && isWordBoundary(runes, i):
+			flush()
+			current = append(current, r)
+		default:
+			current = append(current, r)
+		}
+	}
+	flush()
+	return words
+}
+
+// isWordBoundary reports whether the uppercase rune at runes[i] starts a
+// new word: either the previous rune is lowercase (e.g. "parseURL" splits
+// before "URL"), or the previous rune is uppercase but the next one is
+// lowercase (e.g. "URLParser" splits before "Parser", not before each
+// letter of "URL").
+func isWordBoundary(runes []rune, i int) bool {
+	prev := runes[i-1]
+	if unicode.IsLower(prev) || unicode.IsDigit(prev) {
+		return true
+	}
+	if unicode.IsUpper(prev) && i+1 < len(runes) && unicode.IsLower(runes[i+1]) {
+		return true
+	}
+	return false
+}
+
+// ToCamel renders s in camelCase, e.g. "hello_world" and "parseURL" both
+// normalize to "helloWorld" and "parseUrl" respectively.
+func ToCamel(s string) string {
+	words := splitWords(s)
+	var sb strings.Builder
+	for i, w := range words {
+		if i == 0 {
+			sb.WriteString(w)
+			continue
+		}
+		sb.WriteString(capitalize(w))
+	}
+	return sb.String()
+}
+
+// ToPascal renders s in PascalCase, e.g. "hello_world" normalizes to
+// "HelloWorld".
+func ToPascal(s string) string {
+	words := splitWords(s)
+	var sb strings.Builder
+	for _, w := range words {
+		sb.WriteString(capitalize(w))
+	}
+	return sb.String()
+}
+
+// ToSnake renders s in snake_case, e.g. "parseURL" normalizes to
+// "parse_url".
+func ToSnake(s string) string {
+	return strings.Join(splitWords(s), "_")
+}
+
+// ToKebab renders s in kebab-case, e.g. "parseURL" normalizes to
+// "parse-url".
+func ToKebab(s string) string {
+	return strings.Join(splitWords(s), "-")
+}
+
+// ToTitle renders s in Title Case, e.g. "hello_world" normalizes to
+// "Hello World".
+func ToTitle(s string) string {
+	words := splitWords(s)
+	for i, w := range words {
+		words[i] = capitalize(w)
+	}
+	return strings.Join(words, " ")
+}
+
+// capitalize upper-cases the first rune of a lowercase word, leaving the
+// rest unchanged.
+func capitalize(w string) string {
+	if w == "" {
+		return w
+	}
+	runes := []rune(w)
+	runes[0] = unicode.ToUpper(runes[0])
+	return string(runes)
+}
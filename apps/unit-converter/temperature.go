@@ -0,0 +1,47 @@
+package converter
+
+// affineTransform represents the linear relationship y = A*x + B used to
+// move a temperature from its native scale to Kelvin, the dimension's
+// base unit. Modeling temperature as affine rather than purely
+// multiplicative lets every scale, including inverted ones like
+// Delisle, round-trip through a single base unit.
+type affineTransform struct {
+	A, B float64
+}
+
+func (t affineTransform) apply(x float64) float64  { return t.A*x + t.B }
+func (t affineTransform) invert(y float64) float64 { return (y - t.B) / t.A }
+
+// absoluteZero returns the value, expressed in the transform's own
+// scale, that corresponds to absolute zero (0 Kelvin).
+func (t affineTransform) absoluteZero() float64 { return t.invert(0) }
+
+// registerTemperatureUnit registers a temperature unit that converts to
+// and from Kelvin via toKelvin, and rejects values below absolute zero
+// in the unit's own scale. Scales that run in the opposite direction to
+// Kelvin, such as Delisle (A < 0), are rejected above their absolute
+// zero instead.
+func registerTemperatureUnit(r *Registry, name string, toKelvin affineTransform) {
+	absZero := toKelvin.absoluteZero()
+	inverted := toKelvin.A < 0
+
+	validate := func(v float64) error {
+		belowAbsoluteZero := v < absZero
+		if inverted {
+			belowAbsoluteZero = v > absZero
+		}
+		if belowAbsoluteZero {
+			return &ErrBelowAbsoluteZero{Unit: name, Value: v}
+		}
+		return nil
+	}
+
+	if err := r.RegisterChecked(
+		Unit{Name: name, Dimension: DimensionTemperature},
+		toKelvin.apply,
+		toKelvin.invert,
+		validate,
+	); err != nil {
+		panic(err)
+	}
+}
@@ -0,0 +1,90 @@
+package caseconverter
+
+import "testing"
+
+func TestToCamel(t *testing.T) {
+	tests := []struct {
+		input string
+		want  string
+	}{
+		{"hello_world", "helloWorld"},
+		{"hello-world", "helloWorld"},
+		{"HelloWorld", "helloWorld"},
+		{"hello world", "helloWorld"},
+		{"parseURL", "parseUrl"},
+		{"__hello__world__", "helloWorld"},
+	}
+	for _, tc := range tests {
+		if got := ToCamel(tc.input); got != tc.want {
+			t.Errorf("ToCamel(%q) = %q, want %q", tc.input, got, tc.want)
+		}
+	}
+}
+
+func TestToSnake(t *testing.T) {
+	tests := []struct {
+		input string
+		want  string
+	}{
+		{"hello_world", "hello_world"},
+		{"hello-world", "hello_world"},
+		{"HelloWorld", "hello_world"},
+		{"hello world", "hello_world"},
+		{"parseURL", "parse_url"},
+		{"--hello--world--", "hello_world"},
+	}
+	for _, tc := range tests {
+		if got := ToSnake(tc.input); got != tc.want {
+			t.Errorf("ToSnake(%q) = %q, want %q", tc.input, got, tc.want)
+		}
+	}
+}
+
+func TestToKebab(t *testing.T) {
+	tests := []struct {
+		input string
+		want  string
+	}{
+		{"hello_world", "hello-world"},
+		{"HelloWorld", "hello-world"},
+		{"parseURL", "parse-url"},
+	}
+	for _, tc := range tests {
+		if got := ToKebab(tc.input); got != tc.want {
+			t.Errorf("ToKebab(%q) = %q, want %q", tc.input, got, tc.want)
+		}
+	}
+}
+
+func TestToPascal(t *testing.T) {
+	tests := []struct {
+		input string
+		want  string
+	}{
+		{"hello_world", "HelloWorld"},
+		{"hello-world", "HelloWorld"},
+		{"hello world", "HelloWorld"},
+		{"parseURL", "ParseUrl"},
+	}
+	for _, tc := range tests {
+		if got := ToPascal(tc.input); got != tc.want {
+			t.Errorf("ToPascal(%q) = %q, want %q", tc.input, got, tc.want)
+		}
+	}
+}
+
+func TestToTitle(t *testing.T) {
+	tests := []struct {
+		input string
+		want  string
+	}{
+		{"hello_world", "Hello World"},
+		{"HelloWorld", "Hello World"},
+		{"parseURL", "Parse Url"},
+	}
+	for _, tc := range tests {
+		if got := ToTitle(tc.input); got != tc.want {
+			t.Errorf("ToTitle(%q) = %q, want %q", tc.input, got, tc.want)
+		}
+	}
+}
@@ -0,0 +1,81 @@
+package converter
+
+// defaultRegistry holds the units built into the package. Third-party
+// code can register additional units on it via Register, or build an
+// independent Registry with NewRegistry.
+var defaultRegistry = NewRegistry()
+
+func init() {
+	mustRegister(Unit{Name: "m", Dimension: DimensionLength},
+		func(v float64) float64 { return v },
+		func(v float64) float64 { return v })
+	mustRegister(Unit{Name: "ft", Dimension: DimensionLength},
+		func(v float64) float64 { return v * feetToMetersFactor },
+		func(v float64) float64 { return v * metersToFeetFactor })
+	mustAlias("m", "meter", "meters", "metre", "metres")
+	mustAlias("ft", "foot", "feet")
+	mustRegisterSIPrefixes(defaultRegistry, "m", DimensionLength, siPrefixes)
+
+	registerTemperatureUnit(defaultRegistry, "k", affineTransform{A: 1, B: 0})
+	registerTemperatureUnit(defaultRegistry, "c", affineTransform{A: 1, B: 273.15})
+	registerTemperatureUnit(defaultRegistry, "f", affineTransform{A: 5.0 / 9.0, B: 255.3722222222222})
+	registerTemperatureUnit(defaultRegistry, "r", affineTransform{A: 5.0 / 9.0, B: 0})
+	registerTemperatureUnit(defaultRegistry, "re", affineTransform{A: 5.0 / 4.0, B: 273.15})
+	registerTemperatureUnit(defaultRegistry, "de", affineTransform{A: -2.0 / 3.0, B: 373.15})
+	mustAlias("k", "kelvin")
+	mustAlias("c", "celsius", "°c")
+	mustAlias("f", "fahrenheit", "°f")
+	mustAlias("r", "rankine", "°r")
+	mustAlias("re", "reaumur", "réaumur", "°re")
+	mustAlias("de", "delisle", "°de")
+}
+
+func mustRegister(unit Unit, toBase, fromBase func(float64) float64) {
+	if err := defaultRegistry.Register(unit, toBase, fromBase); err != nil {
+		panic(err)
+	}
+}
+
+func mustAlias(canonical string, names ...string) {
+	if err := defaultRegistry.Alias(canonical, names...); err != nil {
+		panic(err)
+	}
+}
+
+// siPrefix describes one SI prefix that can be layered on top of a base
+// unit, e.g. "k" turns "m" into "km" with a factor of 1e3.
+type siPrefix struct {
+	Symbol string
+	Factor float64
+}
+
+// siPrefixes covers the SI prefixes commonly seen on length and data
+// units (km, cm, mm, µm, ...).
+var siPrefixes = []siPrefix{
+	{"k", 1e3},
+	{"h", 1e2},
+	{"da", 1e1},
+	{"d", 1e-1},
+	{"c", 1e-2},
+	{"m", 1e-3},
+	{"µ", 1e-6},
+	{"n", 1e-9},
+}
+
+// mustRegisterSIPrefixes registers, for each siPrefix, a unit named
+// prefix+baseName whose conversion to/from the dimension's base is a
+// straight multiplication by the prefix factor. baseName must already
+// be registered as the dimension's base unit (toBase/fromBase are the
+// identity function).
+func mustRegisterSIPrefixes(r *Registry, baseName string, dim Dimension, prefixes []siPrefix) {
+	for _, p := range prefixes {
+		factor := p.Factor
+		unit := Unit{Name: p.Symbol + baseName, Dimension: dim}
+		if err := r.Register(unit,
+			func(v float64) float64 { return v * factor },
+			func(v float64) float64 { return v / factor },
+		); err != nil {
+			panic(err)
+		}
+	}
+}
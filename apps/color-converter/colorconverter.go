@@ -0,0 +1,120 @@
+// Package colorconverter converts colors between the hex, RGB and HSL
+// representations commonly used in CSS and design tools.
+package colorconverter
+
+import (
+	"fmt"
+	"math"
+	"strconv"
+	"strings"
+)
+
+// InvalidHexError is returned when a string isn't a valid 3- or
+// 6-digit hex color, with or without a leading "#".
+type InvalidHexError struct {
+	Hex string
+}
+
+func (e *InvalidHexError) Error() string {
+	return fmt.Sprintf("colorconverter: %q is not a valid hex color", e.Hex)
+}
+
+// OutOfRangeError is returned when an RGB component is outside 0-255.
+type OutOfRangeError struct {
+	Component string
+	Value     int
+}
+
+func (e *OutOfRangeError) Error() string {
+	return fmt.Sprintf("colorconverter: %s component %d is out of range 0-255", e.Component, e.Value)
+}
+
+// HexToRGB parses a hex color string such as "#ff0000", "ff0000", or
+// the short form "#f00" / "f00" (where each digit is doubled) into its
+// red, green and blue components.
+func HexToRGB(hex string) (r, g, b int, err error) {
+	h := strings.TrimPrefix(hex, "#")
+	switch len(h) {
+	case 3:
+		h = string([]byte{h[0], h[0], h[1], h[1], h[2], h[2]})
+	case 6:
+		// already full length
+	default:
+		return 0, 0, 0, &InvalidHexError{Hex: hex}
+	}
+
+	n, err := strconv.ParseUint(h, 16, 32)
+	if err != nil {
+		return 0, 0, 0, &InvalidHexError{Hex: hex}
+	}
+	return int(n >> 16 & 0xff), int(n >> 8 & 0xff), int(n & 0xff), nil
+}
+
+// RGBToHex renders r, g, b as a 6-digit hex color with a leading "#",
+// e.g. RGBToHex(255, 0, 0) returns "#ff0000". Each component must be
+// within 0-255.
+func RGBToHex(r, g, b int) (string, error) {
+	if err := validateComponent("r", r); err != nil {
+		return "", err
+	}
+	if err := validateComponent("g", g); err != nil {
+		return "", err
+	}
+	if err := validateComponent("b", b); err != nil {
+		return "", err
+	}
+	return fmt.Sprintf("#%02x%02x%02x", r, g, b), nil
+}
+
+// RGBToHSL converts r, g, b (each 0-255) to hue (degrees, 0-360),
+// saturation and lightness (both 0-1), the representation CSS's
+// hsl() function uses. Each component must be within 0-255.
+func RGBToHSL(r, g, b int) (h, s, l float64, err error) {
+	if err := validateComponent("r", r); err != nil {
+		return 0, 0, 0, err
+	}
+	if err := validateComponent("g", g); err != nil {
+		return 0, 0, 0, err
+	}
+	if err := validateComponent("b", b); err != nil {
+		return 0, 0, 0, err
+	}
+
+	rf, gf, bf := float64(r)/255, float64(g)/255, float64(b)/255
+	max := math.Max(rf, math.Max(gf, bf))
+	min := math.Min(rf, math.Min(gf, bf))
+	l = (max + min) / 2
+
+	if max == min {
+		return 0, 0, l, nil
+	}
+
+	delta := max - min
+	if l > 0.5 {
+		s = delta / (2 - max - min)
+	} else {
+		s = delta / (max + min)
+	}
+
+	switch max {
+	case rf:
+		h = (gf - bf) / delta
+		if gf < bf {
+			h += 6
+		}
+	case gf:
+		h = (bf-rf)/delta + 2
+	default:
+		h = (rf-gf)/delta + 4
+	}
+	h *= 60
+
+	return h, s, l, nil
+}
+
+func validateComponent(name string, v int) error {
+	if v < 0 || v > 255 {
+		return &OutOfRangeError{Component: name, Value: v}
+	}
+	return nil
+}
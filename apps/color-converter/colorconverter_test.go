@@ -0,0 +1,106 @@
+package colorconverter
+
+import (
+	"errors"
+	"math"
+	"testing"
+)
+
+func assertClose(t *testing.T, got, want, tolerance float64) {
+	t.Helper()
+	if math.Abs(got-want) > tolerance {
+		t.Fatalf("got %v, want %v", got, want)
+	}
+}
+
+func TestHexToRGB(t *testing.T) {
+	tests := []struct {
+		hex     string
+		r, g, b int
+	}{
+		{"#ff0000", 255, 0, 0},
+		{"ff0000", 255, 0, 0},
+		{"#f00", 255, 0, 0},
+		{"f00", 255, 0, 0},
+		{"#00ff80", 0, 255, 128},
+	}
+	for _, tc := range tests {
+		r, g, b, err := HexToRGB(tc.hex)
+		if err != nil {
+			t.Fatalf("HexToRGB(%q) returned unexpected error: %v", tc.hex, err)
+		}
+		if r != tc.r || g != tc.g || b != tc.b {
+			t.Errorf("HexToRGB(%q) = (%d, %d, %d), want (%d, %d, %d)", tc.hex, r, g, b, tc.r, tc.g, tc.b)
+		}
+	}
+}
+
+func TestHexToRGBInvalid(t *testing.T) {
+	var target *InvalidHexError
+	for _, hex := range []string{"", "#ff", "#gggggg", "#12345"} {
+		if _, _, _, err := HexToRGB(hex); !errors.As(err, &target) {
+			t.Errorf("HexToRGB(%q): expected *InvalidHexError, got %v", hex, err)
+		}
+	}
+}
+
+func TestRGBToHex(t *testing.T) {
+	got, err := RGBToHex(255, 0, 0)
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if got != "#ff0000" {
+		t.Fatalf("RGBToHex(255, 0, 0) = %q, want %q", got, "#ff0000")
+	}
+}
+
+func TestRGBToHexOutOfRange(t *testing.T) {
+	var target *OutOfRangeError
+	if _, err := RGBToHex(256, 0, 0); !errors.As(err, &target) {
+		t.Fatalf("expected *OutOfRangeError, got %v", err)
+	}
+	if _, err := RGBToHex(0, -1, 0); !errors.As(err, &target) {
+		t.Fatalf("expected *OutOfRangeError, got %v", err)
+	}
+}
+
+func TestHexRGBRoundTrip(t *testing.T) {
+	r, g, b, err := HexToRGB("#3c8fd6")
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	hex, err := RGBToHex(r, g, b)
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if hex != "#3c8fd6" {
+		t.Fatalf("round trip = %q, want %q", hex, "#3c8fd6")
+	}
+}
+
+func TestRGBToHSL(t *testing.T) {
+	h, s, l, err := RGBToHSL(255, 0, 0)
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	assertClose(t, h, 0, 1e-9)
+	assertClose(t, s, 1, 1e-9)
+	assertClose(t, l, 0.5, 1e-9)
+}
+
+func TestRGBToHSLGray(t *testing.T) {
+	h, s, l, err := RGBToHSL(128, 128, 128)
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	assertClose(t, h, 0, 1e-9)
+	assertClose(t, s, 0, 1e-9)
+	assertClose(t, l, 128.0/255, 1e-9)
+}
+
+func TestRGBToHSLOutOfRange(t *testing.T) {
+	var target *OutOfRangeError
+	if _, _, _, err := RGBToHSL(256, 0, 0); !errors.As(err, &target) {
+		t.Fatalf("expected *OutOfRangeError, got %v", err)
+	}
+}
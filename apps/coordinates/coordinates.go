@@ -0,0 +1,105 @@
+// Package coordinates converts geographic coordinates between
+// degrees-minutes-seconds (DMS) and decimal degree notation.
+package coordinates
+
+import "fmt"
+
+// InvalidHemisphereError is returned when a hemisphere letter isn't
+// valid for the coordinate axis it's being used with ("N"/"S" for
+// latitude, "E"/"W" for longitude).
+type InvalidHemisphereError struct {
+	Hemisphere string
+}
+
+func (e *InvalidHemisphereError) Error() string {
+	return fmt.Sprintf("coordinates: invalid hemisphere %q", e.Hemisphere)
+}
+
+// OutOfRangeError is returned when a DMS or decimal value falls
+// outside what's geographically valid.
+type OutOfRangeError struct {
+	Value float64
+}
+
+func (e *OutOfRangeError) Error() string {
+	return fmt.Sprintf("coordinates: %g is outside the valid range", e.Value)
+}
+
+// DMSToDecimal converts a degrees-minutes-seconds angle into decimal
+// degrees, e.g. DMSToDecimal(40, 26, 46, "N") returns approximately
+// 40.4461. hemisphere must be "N" or "S" for latitude, or "E" or "W"
+// for longitude; "S" and "W" negate the result. deg must be
+// non-negative, and min and sec must each be in [0, 60).
+func DMSToDecimal(deg, min, sec float64, hemisphere string) (float64, error) {
+	if deg < 0 {
+		return 0, &OutOfRangeError{Value: deg}
+	}
+	if min < 0 || min >= 60 {
+		return 0, &OutOfRangeError{Value: min}
+	}
+	if sec < 0 || sec >= 60 {
+		return 0, &OutOfRangeError{Value: sec}
+	}
+
+	decimal := deg + min/60 + sec/3600
+
+	var limit float64
+	var signed float64
+	switch hemisphere {
+	case "N", "E":
+		limit = 180
+		if hemisphere == "N" {
+			limit = 90
+		}
+		signed = decimal
+	case "S", "W":
+		limit = 180
+		if hemisphere == "S" {
+			limit = 90
+		}
+		signed = -decimal
+	default:
+		return 0, &InvalidHemisphereError{Hemisphere: hemisphere}
+	}
+
+	if decimal > limit {
+		return 0, &OutOfRangeError{Value: signed}
+	}
+
+	return signed, nil
+}
+
+// DecimalToDMS converts a decimal-degree coordinate into its
+// degrees-minutes-seconds representation, along with the hemisphere
+// letter ("N"/"S" if isLatitude, otherwise "E"/"W"). decimal must be
+// within ±90 for latitude or ±180 for longitude.
+func DecimalToDMS(decimal float64, isLatitude bool) (deg int, min int, sec float64, hemisphere string, err error) {
+	limit := 180.0
+	if isLatitude {
+		limit = 90.0
+	}
+	if decimal < -limit || decimal > limit {
+		return 0, 0, 0, "", &OutOfRangeError{Value: decimal}
+	}
+
+	hemisphere = "E"
+	if isLatitude {
+		hemisphere = "N"
+	}
+	abs := decimal
+	if abs < 0 {
+		abs = -abs
+		if isLatitude {
+			hemisphere = "S"
+		} else {
+			hemisphere = "W"
+		}
+	}
+
+	deg = int(abs)
+	remainder := (abs - float64(deg)) * 60
+	min = int(remainder)
+	sec = (remainder - float64(min)) * 60
+
+	return deg, min, sec, hemisphere, nil
+}
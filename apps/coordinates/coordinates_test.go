@@ -0,0 +1,111 @@
+package coordinates
+
+import (
+	"errors"
+	"math"
+	"testing"
+)
+
+func assertClose(t *testing.T, got, want, tol float64) {
+	t.Helper()
+	if math.Abs(got-want) > tol {
+		t.Fatalf("got %v, want %v", got, want)
+	}
+}
+
+func TestDMSToDecimal(t *testing.T) {
+	got, err := DMSToDecimal(40, 26, 46, "N")
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	assertClose(t, got, 40.4461111111, 1e-6)
+}
+
+func TestDMSToDecimalNegativeHemisphere(t *testing.T) {
+	got, err := DMSToDecimal(74, 0, 21, "W")
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	assertClose(t, got, -74.0058333333, 1e-6)
+}
+
+func TestDMSToDecimalInvalidHemisphere(t *testing.T) {
+	var target *InvalidHemisphereError
+	if _, err := DMSToDecimal(40, 26, 46, "X"); !errors.As(err, &target) {
+		t.Fatalf("expected *InvalidHemisphereError, got %v", err)
+	}
+}
+
+func TestDMSToDecimalOutOfRange(t *testing.T) {
+	var target *OutOfRangeError
+	if _, err := DMSToDecimal(91, 0, 0, "N"); !errors.As(err, &target) {
+		t.Fatalf("expected *OutOfRangeError, got %v", err)
+	}
+	if _, err := DMSToDecimal(200, 0, 0, "E"); !errors.As(err, &target) {
+		t.Fatalf("expected *OutOfRangeError, got %v", err)
+	}
+}
+
+func TestDMSToDecimalInvalidMinutesOrSeconds(t *testing.T) {
+	var target *OutOfRangeError
+	if _, err := DMSToDecimal(0, 90, 0, "N"); !errors.As(err, &target) {
+		t.Fatalf("expected *OutOfRangeError, got %v", err)
+	}
+	if _, err := DMSToDecimal(0, 0, 100, "N"); !errors.As(err, &target) {
+		t.Fatalf("expected *OutOfRangeError, got %v", err)
+	}
+}
+
+func TestDecimalToDMS(t *testing.T) {
+	deg, min, sec, hemisphere, err := DecimalToDMS(40.4461111111, true)
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if deg != 40 || min != 26 {
+		t.Fatalf("DecimalToDMS = (%d, %d, %g, %q), want deg=40 min=26", deg, min, sec, hemisphere)
+	}
+	assertClose(t, sec, 46, 0.01)
+	if hemisphere != "N" {
+		t.Fatalf("hemisphere = %q, want %q", hemisphere, "N")
+	}
+}
+
+func TestDecimalToDMSNegative(t *testing.T) {
+	deg, min, sec, hemisphere, err := DecimalToDMS(-74.0058333333, false)
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if deg != 74 || min != 0 {
+		t.Fatalf("DecimalToDMS = (%d, %d, %g, %q), want deg=74 min=0", deg, min, sec, hemisphere)
+	}
+	assertClose(t, sec, 21, 0.01)
+	if hemisphere != "W" {
+		t.Fatalf("hemisphere = %q, want %q", hemisphere, "W")
+	}
+}
+
+func TestDecimalToDMSOutOfRange(t *testing.T) {
+	var target *OutOfRangeError
+	if _, _, _, _, err := DecimalToDMS(200, false); !errors.As(err, &target) {
+		t.Fatalf("expected *OutOfRangeError, got %v", err)
+	}
+	if _, _, _, _, err := DecimalToDMS(100, true); !errors.As(err, &target) {
+		t.Fatalf("expected *OutOfRangeError, got %v", err)
+	}
+}
+
+func TestRoundTrip(t *testing.T) {
+	decimal, err := DMSToDecimal(40, 26, 46, "N")
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	deg, min, sec, hemisphere, err := DecimalToDMS(decimal, true)
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	roundTripped, err := DMSToDecimal(float64(deg), float64(min), sec, hemisphere)
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	assertClose(t, roundTripped, decimal, 1e-6)
+}
@@ -0,0 +1,174 @@
+// Package cronnext parses standard 5-field cron expressions (minute
+// hour day-of-month month day-of-week) and computes the next time a
+// schedule fires.
+package cronnext
+
+import (
+	"fmt"
+	"strconv"
+	"strings"
+	"time"
+)
+
+// MalformedExpressionError is returned when a cron expression doesn't
+// parse: the wrong number of fields, a value out of range, or syntax
+// NextRun doesn't understand.
+type MalformedExpressionError struct {
+	Expr   string
+	Reason string
+}
+
+func (e *MalformedExpressionError) Error() string {
+	return fmt.Sprintf("cronnext: invalid cron expression %q: %s", e.Expr, e.Reason)
+}
+
+// fieldRange describes the valid bounds for one of the five cron
+// fields, used to validate and expand "*", "*/n", ranges, and lists.
+type fieldRange struct {
+	min, max int
+}
+
+var (
+	minuteRange = fieldRange{0, 59}
+	hourRange   = fieldRange{0, 23}
+	domRange    = fieldRange{1, 31}
+	monthRange  = fieldRange{1, 12}
+	dowRange    = fieldRange{0, 6} // 0 = Sunday
+)
+
+// schedule holds the expanded set of allowed values for each field of
+// a parsed cron expression.
+type schedule struct {
+	minutes, hours, doms, months, dows map[int]bool
+}
+
+// parse parses a standard 5-field cron expression.
+func parse(expr string) (*schedule, error) {
+	fields := strings.Fields(expr)
+	if len(fields) != 5 {
+		return nil, &MalformedExpressionError{Expr: expr, Reason: fmt.Sprintf("want 5 fields, got %d", len(fields))}
+	}
+
+	minutes, err := parseField(fields[0], minuteRange)
+	if err != nil {
+		return nil, &MalformedExpressionError{Expr: expr, Reason: err.Error()}
+	}
+	hours, err := parseField(fields[1], hourRange)
+	if err != nil {
+		return nil, &MalformedExpressionError{Expr: expr, Reason: err.Error()}
+	}
+	doms, err := parseField(fields[2], domRange)
+	if err != nil {
+		return nil, &MalformedExpressionError{Expr: expr, Reason: err.Error()}
+	}
+	months, err := parseField(fields[3], monthRange)
+	if err != nil {
+		return nil, &MalformedExpressionError{Expr: expr, Reason: err.Error()}
+	}
+	dows, err := parseField(fields[4], dowRange)
+	if err != nil {
+		return nil, &MalformedExpressionError{Expr: expr, Reason: err.Error()}
+	}
+
+	return &schedule{minutes: minutes, hours: hours, doms: doms, months: months, dows: dows}, nil
+}
+
+// parseField expands one comma-separated cron field (each part a "*",
+// "*/step", "a-b", "a-b/step", or a plain number) into the set of
+// values it allows, bounded by r.
+func parseField(field string, r fieldRange) (map[int]bool, error) {
+	values := make(map[int]bool)
+	for _, part := range strings.Split(field, ",") {
+		if err := parsePart(part, r, values); err != nil {
+			return nil, err
+		}
+	}
+	return values, nil
+}
+
+func parsePart(part string, r fieldRange, values map[int]bool) error {
+	rangePart, step := part, 1
+	if idx := strings.IndexByte(part, '/'); idx >= 0 {
+		rangePart = part[:idx]
+		n, err := strconv.Atoi(part[idx+1:])
+		if err != nil || n <= 0 {
+			return fmt.Errorf("invalid step %q", part[idx+1:])
+		}
+		step = n
+	}
+
+	lo, hi := r.min, r.max
+	switch {
+	case rangePart == "*":
+		// lo, hi already cover the whole field.
+	case strings.Contains(rangePart, "-"):
+		bounds := strings.SplitN(rangePart, "-", 2)
+		a, err1 := strconv.Atoi(bounds[0])
+		b, err2 := strconv.Atoi(bounds[1])
+		if err1 != nil || err2 != nil || a > b {
+			return fmt.Errorf("invalid range %q", rangePart)
+		}
+		lo, hi = a, b
+	default:
+		n, err := strconv.Atoi(rangePart)
+		if err != nil {
+			return fmt.Errorf("invalid value %q", rangePart)
+		}
+		lo, hi = n, n
+	}
+
+	if lo < r.min || hi > r.max {
+		return fmt.Errorf("value %q out of range [%d, %d]", part, r.min, r.max)
+	}
+	for v := lo; v <= hi; v += step {
+		values[v] = true
+	}
+	return nil
+}
+
+// matches reports whether t satisfies the schedule. As in standard
+// cron, if both day-of-month and day-of-week are restricted (not "*"),
+// a day matching either field fires; if only one is restricted, that
+// one alone decides.
+func (s *schedule) matches(t time.Time) bool {
+	if !s.minutes[t.Minute()] || !s.hours[t.Hour()] || !s.months[int(t.Month())] {
+		return false
+	}
+
+	domRestricted := len(s.doms) != domRange.max-domRange.min+1
+	dowRestricted := len(s.dows) != dowRange.max-dowRange.min+1
+
+	domOK := s.doms[t.Day()]
+	dowOK := s.dows[int(t.Weekday())]
+
+	switch {
+	case domRestricted && dowRestricted:
+		return domOK || dowOK
+	case domRestricted:
+		return domOK
+	case dowRestricted:
+		return dowOK
+	default:
+		return true
+	}
+}
+
+// NextRun parses expr and returns the next time, strictly after after,
+// at which the schedule fires. Minutes are the finest resolution
+// considered; seconds and sub-seconds of after are ignored.
+func NextRun(expr string, after time.Time) (time.Time, error) {
+	sched, err := parse(expr)
+	if err != nil {
+		return time.Time{}, err
+	}
+
+	t := after.Truncate(time.Minute).Add(time.Minute)
+	limit := after.AddDate(5, 0, 0)
+	for t.Before(limit) {
+		if sched.matches(t) {
+			return t, nil
+		}
+		t = t.Add(time.Minute)
+	}
+	return time.Time{}, &MalformedExpressionError{Expr: expr, Reason: "no matching time found within 5 years"}
+}
@@ -0,0 +1,73 @@
+package cronnext
+
+import (
+	"errors"
+	"testing"
+	"time"
+)
+
+func TestNextRunEveryQuarterHour(t *testing.T) {
+	after := time.Date(2026, 1, 5, 10, 7, 0, 0, time.UTC)
+	got, err := NextRun("*/15 * * * *", after)
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	want := time.Date(2026, 1, 5, 10, 15, 0, 0, time.UTC)
+	if !got.Equal(want) {
+		t.Fatalf("NextRun = %v, want %v", got, want)
+	}
+}
+
+func TestNextRunWeekdayMorning(t *testing.T) {
+	// 2026-01-03 is a Saturday.
+	after := time.Date(2026, 1, 3, 10, 0, 0, 0, time.UTC)
+	got, err := NextRun("0 9 * * 1-5", after)
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	want := time.Date(2026, 1, 5, 9, 0, 0, 0, time.UTC) // next Monday
+	if !got.Equal(want) {
+		t.Fatalf("NextRun = %v, want %v", got, want)
+	}
+}
+
+func TestNextRunAdvancesPastAfter(t *testing.T) {
+	after := time.Date(2026, 1, 5, 9, 0, 0, 0, time.UTC)
+	got, err := NextRun("0 9 * * *", after)
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	want := time.Date(2026, 1, 6, 9, 0, 0, 0, time.UTC)
+	if !got.Equal(want) {
+		t.Fatalf("NextRun = %v, want %v", got, want)
+	}
+}
+
+func TestNextRunDomOrDow(t *testing.T) {
+	// Fires on the 1st of the month OR any Friday, whichever comes
+	// first, matching standard cron semantics when both fields are
+	// restricted.
+	after := time.Date(2026, 1, 5, 0, 0, 0, 0, time.UTC) // Monday
+	got, err := NextRun("0 0 1 * 5", after)
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	want := time.Date(2026, 1, 9, 0, 0, 0, 0, time.UTC) // next Friday
+	if !got.Equal(want) {
+		t.Fatalf("NextRun = %v, want %v", got, want)
+	}
+}
+
+func TestNextRunMalformedFieldCount(t *testing.T) {
+	var target *MalformedExpressionError
+	if _, err := NextRun("* * *", time.Now()); !errors.As(err, &target) {
+		t.Fatalf("expected *MalformedExpressionError, got %v", err)
+	}
+}
+
+func TestNextRunMalformedValue(t *testing.T) {
+	var target *MalformedExpressionError
+	if _, err := NextRun("99 * * * *", time.Now()); !errors.As(err, &target) {
+		t.Fatalf("expected *MalformedExpressionError, got %v", err)
+	}
+}
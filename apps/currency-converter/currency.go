@@ -0,0 +1,99 @@
+// Package currency converts amounts between currencies using a
+// pluggable RateProvider, so the conversion logic never depends on
+// where exchange rates actually come from (a live API, a database, or
+// a fixed table in tests).
+package currency
+
+import (
+	"fmt"
+	"math"
+)
+
+// RateProvider reports the exchange rate to multiply an amount in from
+// by to get the equivalent amount in to.
+type RateProvider interface {
+	Rate(from, to string) (float64, error)
+}
+
+// UnknownCurrencyError is returned when a currency code is not known
+// to the RateProvider in use.
+type UnknownCurrencyError struct {
+	Code string
+}
+
+func (e *UnknownCurrencyError) Error() string {
+	return fmt.Sprintf("currency: unknown currency code %q", e.Code)
+}
+
+// NegativeAmountError is returned when Convert is given a negative
+// amount, which is never a valid currency conversion input.
+type NegativeAmountError struct {
+	Amount float64
+}
+
+func (e *NegativeAmountError) Error() string {
+	return fmt.Sprintf("currency: amount must not be negative, got %g", e.Amount)
+}
+
+// Convert converts amount from currency from to currency to using the
+// exchange rate reported by p. amount must not be negative.
+func Convert(amount float64, from, to string, p RateProvider) (float64, error) {
+	if amount < 0 {
+		return 0, &NegativeAmountError{Amount: amount}
+	}
+	rate, err := p.Rate(from, to)
+	if err != nil {
+		return 0, err
+	}
+	return amount * rate, nil
+}
+
+// ConvertMoney converts amount, like Convert, but returns the result
+// in integer minor units (e.g. cents) rounded to the nearest whole
+// unit rather than as a float. Rounding the final result once, instead
+// of summing already-rounded intermediate amounts, keeps a batch of
+// conversions from drifting away from the mathematically correct total
+// by a cent here and there. amount must not be negative.
+func ConvertMoney(amount float64, from, to string, p RateProvider) (int64, error) {
+	converted, err := Convert(amount, from, to, p)
+	if err != nil {
+		return 0, err
+	}
+	return int64(math.Round(converted * 100)), nil
+}
+
+// StaticRates is an in-memory RateProvider backed by a fixed table of
+// rates against a base currency, so it needs no network access and is
+// convenient for tests and offline use. Rates maps a currency code to
+// how many units of that currency equal one unit of Base; Base itself
+// does not need an entry, since it is implicitly 1.
+type StaticRates struct {
+	Base  string
+	Rates map[string]float64
+}
+
+// Rate implements RateProvider by computing the cross-rate between
+// from and to through Base: both are first expressed in units of Base,
+// and the ratio of those gives the direct rate.
+func (s StaticRates) Rate(from, to string) (float64, error) {
+	fromRate, err := s.rateAgainstBase(from)
+	if err != nil {
+		return 0, err
+	}
+	toRate, err := s.rateAgainstBase(to)
+	if err != nil {
+		return 0, err
+	}
+	return toRate / fromRate, nil
+}
+
+func (s StaticRates) rateAgainstBase(code string) (float64, error) {
+	if code == s.Base {
+		return 1, nil
+	}
+	rate, ok := s.Rates[code]
+	if !ok {
+		return 0, &UnknownCurrencyError{Code: code}
+	}
+	return rate, nil
+}
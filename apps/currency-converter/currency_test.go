@@ -0,0 +1,85 @@
+package currency
+
+import (
+	"math"
+	"testing"
+)
+
+func assertClose(t *testing.T, got, want, tol float64) {
+	t.Helper()
+	if math.Abs(got-want) > tol {
+		t.Fatalf("got %v, want %v", got, want)
+	}
+}
+
+func usdRates() StaticRates {
+	return StaticRates{
+		Base: "USD",
+		Rates: map[string]float64{
+			"EUR": 0.92,
+			"GBP": 0.79,
+		},
+	}
+}
+
+func TestConvertBaseToQuote(t *testing.T) {
+	got, err := Convert(100, "USD", "EUR", usdRates())
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	assertClose(t, got, 92, 1e-9)
+}
+
+func TestConvertCrossRate(t *testing.T) {
+	got, err := Convert(100, "EUR", "GBP", usdRates())
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	want := 100 * (0.79 / 0.92)
+	assertClose(t, got, want, 1e-9)
+}
+
+func TestConvertSameCurrency(t *testing.T) {
+	got, err := Convert(50, "USD", "USD", usdRates())
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	assertClose(t, got, 50, 1e-9)
+}
+
+func TestConvertUnknownCurrency(t *testing.T) {
+	_, err := Convert(100, "USD", "ZZZ", usdRates())
+	if _, ok := err.(*UnknownCurrencyError); !ok {
+		t.Fatalf("expected *UnknownCurrencyError, got %T: %v", err, err)
+	}
+}
+
+func TestConvertNegativeAmount(t *testing.T) {
+	_, err := Convert(-1, "USD", "EUR", usdRates())
+	if _, ok := err.(*NegativeAmountError); !ok {
+		t.Fatalf("expected *NegativeAmountError, got %T: %v", err, err)
+	}
+}
+
+func TestConvertMoneyRoundsAwayFloatDrift(t *testing.T) {
+	// 100 * 1.3171 * 100 == 13170.999999999998 as a float64, so a naive
+	// truncation to cents would silently lose a cent.
+	rates := StaticRates{
+		Base:  "USD",
+		Rates: map[string]float64{"JPY": 1.3171},
+	}
+	got, err := ConvertMoney(100, "USD", "JPY", rates)
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if got != 13171 {
+		t.Fatalf("ConvertMoney(100, USD, JPY) = %d, want 13171", got)
+	}
+}
+
+func TestConvertMoneyUnknownCurrency(t *testing.T) {
+	_, err := ConvertMoney(100, "USD", "ZZZ", usdRates())
+	if _, ok := err.(*UnknownCurrencyError); !ok {
+		t.Fatalf("expected *UnknownCurrencyError, got %T: %v", err, err)
+	}
+}
@@ -0,0 +1,65 @@
+// Package datarateconverter converts network data rates between
+// decimal bit-per-second units (bps, Kbps, Mbps, Gbps) and
+// byte-per-second units (Bps, KB/s, MB/s). Unlike most of the other
+// converters in this repository, unit names here are matched
+// case-sensitively on purpose: "Mbps" and "MB/s" differ by a factor of
+// 8, and normalizing case would erase the one distinction this package
+// exists to get right.
+package datarateconverter
+
+import "fmt"
+
+// UnknownUnitError is returned when a conversion references a unit
+// name that isn't one of the supported bit- or byte-per-second units.
+type UnknownUnitError struct {
+	Unit string
+}
+
+func (e *UnknownUnitError) Error() string {
+	return fmt.Sprintf("datarateconverter: unknown unit %q", e.Unit)
+}
+
+// NegativeValueError is returned when a negative data rate is given; a
+// rate can't be negative.
+type NegativeValueError struct {
+	Value float64
+}
+
+func (e *NegativeValueError) Error() string {
+	return fmt.Sprintf("datarateconverter: value must not be negative, got %g", e.Value)
+}
+
+// bitsPerSecond maps each supported unit to its rate in bits per
+// second, the common base every conversion passes through. Byte units
+// are 8x their same-magnitude bit counterpart, which is where the
+// classic bit/byte mixup comes from.
+var bitsPerSecond = map[string]float64{
+	"bps":  1,
+	"Kbps": 1e3,
+	"Mbps": 1e6,
+	"Gbps": 1e9,
+	"Bps":  8,
+	"KB/s": 8e3,
+	"MB/s": 8e6,
+}
+
+// Convert converts value from one data-rate unit to another. Unit
+// names are matched case-sensitively against bitsPerSecond's keys; an
+// UnknownUnitError is returned for anything else, and a
+// NegativeValueError is returned for a negative value.
+func Convert(value float64, from, to string) (float64, error) {
+	if value < 0 {
+		return 0, &NegativeValueError{Value: value}
+	}
+
+	fromFactor, ok := bitsPerSecond[from]
+	if !ok {
+		return 0, &UnknownUnitError{Unit: from}
+	}
+	toFactor, ok := bitsPerSecond[to]
+	if !ok {
+		return 0, &UnknownUnitError{Unit: to}
+	}
+
+	return value * fromFactor / toFactor, nil
+}
@@ -0,0 +1,54 @@
+package datarateconverter
+
+import "testing"
+
+func TestConvertBitToByteCrossover(t *testing.T) {
+	got, err := Convert(100, "Mbps", "MB/s")
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if got != 12.5 {
+		t.Fatalf("Convert(100, Mbps, MB/s) = %v, want 12.5", got)
+	}
+}
+
+func TestConvertSameFamily(t *testing.T) {
+	got, err := Convert(1000, "Kbps", "Mbps")
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if got != 1 {
+		t.Fatalf("Convert(1000, Kbps, Mbps) = %v, want 1", got)
+	}
+}
+
+func TestConvertBitByteSameMagnitude(t *testing.T) {
+	got, err := Convert(8, "bps", "Bps")
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if got != 1 {
+		t.Fatalf("Convert(8, bps, Bps) = %v, want 1", got)
+	}
+}
+
+func TestConvertUnknownUnit(t *testing.T) {
+	if _, err := Convert(1, "bogus", "Mbps"); err == nil {
+		t.Fatal("expected an error for an unknown unit")
+	}
+	if _, err := Convert(1, "Mbps", "bogus"); err == nil {
+		t.Fatal("expected an error for an unknown unit")
+	}
+}
+
+func TestConvertCaseSensitive(t *testing.T) {
+	if _, err := Convert(1, "mbps", "Mbps"); err == nil {
+		t.Fatal("expected lowercase \"mbps\" to be rejected as distinct from \"Mbps\"")
+	}
+}
+
+func TestConvertNegativeValue(t *testing.T) {
+	if _, err := Convert(-1, "Mbps", "Kbps"); err == nil {
+		t.Fatal("expected an error for a negative value")
+	}
+}
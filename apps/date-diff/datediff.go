@@ -0,0 +1,50 @@
+// Package datediff computes the difference between two times, broken
+// into human-readable components, and counts business days between
+// them.
+package datediff
+
+import "time"
+
+// Between returns the absolute difference between a and b, broken
+// into days, hours, and minutes (each the remainder after the larger
+// components are removed, not a running total), e.g. a 26-hour gap
+// returns (1, 2, 0). The order of a and b does not matter.
+func Between(a, b time.Time) (days, hours, minutes int) {
+	d := b.Sub(a)
+	if d < 0 {
+		d = -d
+	}
+
+	days = int(d / (24 * time.Hour))
+	d -= time.Duration(days) * 24 * time.Hour
+	hours = int(d / time.Hour)
+	d -= time.Duration(hours) * time.Hour
+	minutes = int(d / time.Minute)
+	return days, hours, minutes
+}
+
+// BusinessDays counts weekdays (Monday-Friday) between a and b,
+// inclusive of the day a falls on and exclusive of the day b falls on.
+// If a is after b, they are swapped first so the count is always
+// non-negative. Only the calendar date matters; time-of-day is
+// ignored, and each date is read in its own time's Location.
+func BusinessDays(a, b time.Time) int {
+	if a.After(b) {
+		a, b = b, a
+	}
+
+	start := truncateToDate(a)
+	end := truncateToDate(b)
+
+	count := 0
+	for d := start; d.Before(end); d = d.AddDate(0, 0, 1) {
+		if wd := d.Weekday(); wd != time.Saturday && wd != time.Sunday {
+			count++
+		}
+	}
+	return count
+}
+
+func truncateToDate(t time.Time) time.Time {
+	return time.Date(t.Year(), t.Month(), t.Day(), 0, 0, 0, 0, t.Location())
+}
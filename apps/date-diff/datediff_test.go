@@ -0,0 +1,83 @@
+package datediff
+
+import (
+	"testing"
+	"time"
+)
+
+func TestBetween(t *testing.T) {
+	a := time.Date(2026, 1, 1, 0, 0, 0, 0, time.UTC)
+	b := time.Date(2026, 1, 3, 0, 0, 0, 0, time.UTC)
+
+	days, hours, minutes := Between(a, b)
+	if days != 2 || hours != 0 || minutes != 0 {
+		t.Fatalf("Between(a, b) = (%d, %d, %d), want (2, 0, 0)", days, hours, minutes)
+	}
+}
+
+func TestBetweenOrderIndependent(t *testing.T) {
+	a := time.Date(2026, 1, 1, 0, 0, 0, 0, time.UTC)
+	b := time.Date(2026, 1, 3, 0, 0, 0, 0, time.UTC)
+
+	days, hours, minutes := Between(b, a)
+	if days != 2 || hours != 0 || minutes != 0 {
+		t.Fatalf("Between(b, a) = (%d, %d, %d), want (2, 0, 0)", days, hours, minutes)
+	}
+}
+
+func TestBetweenRemainderComponents(t *testing.T) {
+	a := time.Date(2026, 1, 1, 0, 0, 0, 0, time.UTC)
+	b := a.Add(26*time.Hour + 15*time.Minute)
+
+	days, hours, minutes := Between(a, b)
+	if days != 1 || hours != 2 || minutes != 15 {
+		t.Fatalf("Between(a, b) = (%d, %d, %d), want (1, 2, 15)", days, hours, minutes)
+	}
+}
+
+func TestBetweenSameDay(t *testing.T) {
+	a := time.Date(2026, 1, 1, 9, 0, 0, 0, time.UTC)
+	days, hours, minutes := Between(a, a)
+	if days != 0 || hours != 0 || minutes != 0 {
+		t.Fatalf("Between(a, a) = (%d, %d, %d), want (0, 0, 0)", days, hours, minutes)
+	}
+}
+
+func TestBusinessDaysAcrossWeekend(t *testing.T) {
+	// Friday 2026-01-02 through Monday 2026-01-05 (exclusive): Fri only.
+	friday := time.Date(2026, 1, 2, 0, 0, 0, 0, time.UTC)
+	monday := time.Date(2026, 1, 5, 0, 0, 0, 0, time.UTC)
+
+	got := BusinessDays(friday, monday)
+	if got != 1 {
+		t.Fatalf("BusinessDays(Fri, Mon) = %d, want 1", got)
+	}
+}
+
+func TestBusinessDaysFullWeek(t *testing.T) {
+	monday := time.Date(2026, 1, 5, 0, 0, 0, 0, time.UTC)
+	nextMonday := time.Date(2026, 1, 12, 0, 0, 0, 0, time.UTC)
+
+	got := BusinessDays(monday, nextMonday)
+	if got != 5 {
+		t.Fatalf("BusinessDays(Mon, nextMon) = %d, want 5", got)
+	}
+}
+
+func TestBusinessDaysSameDay(t *testing.T) {
+	monday := time.Date(2026, 1, 5, 0, 0, 0, 0, time.UTC)
+	got := BusinessDays(monday, monday)
+	if got != 0 {
+		t.Fatalf("BusinessDays(a, a) = %d, want 0", got)
+	}
+}
+
+func TestBusinessDaysSwapsOutOfOrder(t *testing.T) {
+	friday := time.Date(2026, 1, 2, 0, 0, 0, 0, time.UTC)
+	monday := time.Date(2026, 1, 5, 0, 0, 0, 0, time.UTC)
+
+	got := BusinessDays(monday, friday)
+	if got != 1 {
+		t.Fatalf("BusinessDays(Mon, Fri) = %d, want 1", got)
+	}
+}
@@ -0,0 +1,53 @@
+package encodingtool
+
+import (
+	"bytes"
+	"errors"
+	"testing"
+)
+
+func TestRoundTrip(t *testing.T) {
+	data := []byte{0x00, 0x01, 0xff, 'h', 'i', ' ', '+', '/', '?', '&'}
+
+	for _, scheme := range Schemes() {
+		encoded, err := Encode(data, scheme)
+		if err != nil {
+			t.Fatalf("Encode(%s) unexpected error: %v", scheme, err)
+		}
+		decoded, err := Decode(encoded, scheme)
+		if err != nil {
+			t.Fatalf("Decode(%s) unexpected error: %v", scheme, err)
+		}
+		if !bytes.Equal(decoded, data) {
+			t.Fatalf("%s round-trip = %v, want %v", scheme, decoded, data)
+		}
+	}
+}
+
+func TestDecodeCorruptBase64(t *testing.T) {
+	var target *DecodeError
+	if _, err := Decode("not-valid-base64!!", "base64"); !errors.As(err, &target) {
+		t.Fatalf("expected *DecodeError, got %v", err)
+	}
+}
+
+func TestDecodeInvalidHex(t *testing.T) {
+	var target *DecodeError
+	if _, err := Decode("zz", "hex"); !errors.As(err, &target) {
+		t.Fatalf("expected *DecodeError, got %v", err)
+	}
+}
+
+func TestEncodeUnknownScheme(t *testing.T) {
+	var target *UnknownSchemeError
+	if _, err := Encode([]byte("hi"), "bogus"); !errors.As(err, &target) {
+		t.Fatalf("expected *UnknownSchemeError, got %v", err)
+	}
+}
+
+func TestDecodeUnknownScheme(t *testing.T) {
+	var target *UnknownSchemeError
+	if _, err := Decode("hi", "bogus"); !errors.As(err, &target) {
+		t.Fatalf("expected *UnknownSchemeError, got %v", err)
+	}
+}
@@ -0,0 +1,69 @@
+// Package feelslike computes "feels like" temperatures using the
+// standard NOAA heat-index and wind-chill regressions, both of which
+// are defined in Fahrenheit and mph. Callers pass and receive Celsius
+// and km/h; internally the package converts via the unit-converter
+// package and converts the result back, so none of that unit juggling
+// leaks into the public API.
+package feelslike
+
+import (
+	"fmt"
+	"math"
+
+	converter "github.com/richoandika/community-toolbox/apps/unit-converter"
+)
+
+// RangeError is returned when an input falls outside the range the
+// underlying NOAA formula is defined for.
+type RangeError struct {
+	Field string
+	Value float64
+}
+
+func (e *RangeError) Error() string {
+	return fmt.Sprintf("feelslike: %s is out of range, got %g", e.Field, e.Value)
+}
+
+// HeatIndex computes the NOAA heat index for tempC (Celsius) and
+// humidityPct (0-100), returning the result in Celsius. humidityPct
+// must be between 0 and 100 inclusive.
+func HeatIndex(tempC, humidityPct float64) (float64, error) {
+	if humidityPct < 0 || humidityPct > 100 {
+		return 0, &RangeError{Field: "humidity", Value: humidityPct}
+	}
+
+	tempF, err := converter.Convert("c2f", tempC)
+	if err != nil {
+		return 0, err
+	}
+
+	t, r := tempF, humidityPct
+	hiF := -42.379 + 2.04901523*t + 10.14333127*r -
+		0.22475541*t*r - 0.00683783*t*t - 0.05481717*r*r +
+		0.00122874*t*t*r + 0.00085282*t*r*r - 0.00000199*t*t*r*r
+
+	return converter.Convert("f2c", hiF)
+}
+
+// WindChill computes the NOAA wind chill for tempC (Celsius) and
+// windKmh (km/h), returning the result in Celsius. windKmh must not be
+// negative.
+func WindChill(tempC, windKmh float64) (float64, error) {
+	if windKmh < 0 {
+		return 0, &RangeError{Field: "wind speed", Value: windKmh}
+	}
+
+	tempF, err := converter.Convert("c2f", tempC)
+	if err != nil {
+		return 0, err
+	}
+	windMph, err := converter.Convert("kmh2mph", windKmh)
+	if err != nil {
+		return 0, err
+	}
+
+	v16 := math.Pow(windMph, 0.16)
+	wcF := 35.74 + 0.6215*tempF - 35.75*v16 + 0.4275*tempF*v16
+
+	return converter.Convert("f2c", wcF)
+}
@@ -0,0 +1,47 @@
+package feelslike
+
+import (
+	"math"
+	"testing"
+)
+
+func assertClose(t *testing.T, got, want, tol float64) {
+	t.Helper()
+	if math.Abs(got-want) > tol {
+		t.Fatalf("got %v, want %v", got, want)
+	}
+}
+
+func TestHeatIndex(t *testing.T) {
+	// Reference: 90F, 70% RH yields a heat index of about 105.9F
+	// (≈41.07C), per the NOAA heat index regression.
+	got, err := HeatIndex(32.222222, 70)
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	assertClose(t, got, 41.07, 0.1)
+}
+
+func TestHeatIndexInvalidHumidity(t *testing.T) {
+	for _, h := range []float64{-1, 101} {
+		if _, err := HeatIndex(30, h); err == nil {
+			t.Fatalf("HeatIndex with humidity %v: expected error", h)
+		}
+	}
+}
+
+func TestWindChill(t *testing.T) {
+	// Reference: 20F with a 10mph wind yields a wind chill of about
+	// 8.85F (≈-12.86C), per the NOAA wind chill regression.
+	got, err := WindChill(-6.666667, 16.0934)
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	assertClose(t, got, -12.86, 0.1)
+}
+
+func TestWindChillNegativeWind(t *testing.T) {
+	if _, err := WindChill(0, -1); err == nil {
+		t.Fatal("expected error for negative wind speed")
+	}
+}
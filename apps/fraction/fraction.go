@@ -0,0 +1,127 @@
+// Package fraction converts between fractions and decimals: parsing
+// fraction strings (including mixed numbers), rendering a fraction as
+// a decimal, and approximating a decimal as a fraction bounded by a
+// maximum denominator. It is a useful companion to the
+// cooking-measurement conversions, where fractional cups are common.
+package fraction
+
+import (
+	"errors"
+	"fmt"
+	"math"
+	"strconv"
+	"strings"
+)
+
+// ErrZeroDenominator is returned by ToDecimal when den is zero.
+var ErrZeroDenominator = errors.New("fraction: denominator must not be zero")
+
+// Parse reads a fraction string such as "3/4" or a mixed number such
+// as "1 1/2" and returns its numerator and denominator. The fraction
+// is not reduced.
+func Parse(s string) (num, den int, err error) {
+	fields := strings.Fields(s)
+	switch len(fields) {
+	case 1:
+		return parsePart(fields[0])
+	case 2:
+		whole, err := strconv.Atoi(fields[0])
+		if err != nil {
+			return 0, 0, fmt.Errorf("fraction: %q is not a valid mixed number", s)
+		}
+		n, d, err := parsePart(fields[1])
+		if err != nil {
+			return 0, 0, err
+		}
+		if whole < 0 {
+			return whole*d - n, d, nil
+		}
+		return whole*d + n, d, nil
+	default:
+		return 0, 0, fmt.Errorf("fraction: %q is not a valid fraction", s)
+	}
+}
+
+// parsePart parses a single fraction term like "3/4" or a bare
+// integer like "5" (treated as 5/1).
+func parsePart(s string) (num, den int, err error) {
+	before, after, ok := strings.Cut(s, "/")
+	if !ok {
+		n, err := strconv.Atoi(before)
+		if err != nil {
+			return 0, 0, fmt.Errorf("fraction: %q is not a valid fraction", s)
+		}
+		return n, 1, nil
+	}
+
+	n, errN := strconv.Atoi(before)
+	d, errD := strconv.Atoi(after)
+	if errN != nil || errD != nil || d == 0 {
+		return 0, 0, fmt.Errorf("fraction: %q is not a valid fraction", s)
+	}
+	return n, d, nil
+}
+
+// ToDecimal returns num/den as a float64. den must not be zero.
+func ToDecimal(num, den int) (float64, error) {
+	if den == 0 {
+		return 0, ErrZeroDenominator
+	}
+	return float64(num) / float64(den), nil
+}
+
+// FromDecimal approximates value as a fraction with a denominator no
+// greater than maxDenominator, using a continued-fraction expansion,
+// and reduces the result to lowest terms.
+func FromDecimal(value float64, maxDenominator int) (num, den int) {
+	sign := 1
+	if value < 0 {
+		sign = -1
+		value = -value
+	}
+
+	h0, h1 := 0, 1
+	k0, k1 := 1, 0
+	x := value
+	for {
+		a := int(math.Floor(x))
+		h2 := a*h1 + h0
+		k2 := a*k1 + k0
+		if k2 > maxDenominator {
+			break
+		}
+		h0, h1 = h1, h2
+		k0, k1 = k1, k2
+
+		frac := x - math.Floor(x)
+		if frac < 1e-12 {
+			break
+		}
+		x = 1 / frac
+	}
+
+	num = sign * h1
+	den = k1
+	if den == 0 {
+		den = 1
+	}
+	if g := gcd(abs(num), den); g > 0 {
+		num /= g
+		den /= g
+	}
+	return num, den
+}
+
+func gcd(a, b int) int {
+	for b != 0 {
+		a, b = b, a%b
+	}
+	return a
+}
+
+func abs(n int) int {
+	if n < 0 {
+		return -n
+	}
+	return n
+}
@@ -0,0 +1,98 @@
+package fraction
+
+import (
+	"errors"
+	"math"
+	"testing"
+)
+
+func assertClose(t *testing.T, got, want, tol float64) {
+	t.Helper()
+	if math.Abs(got-want) > tol {
+		t.Fatalf("got %v, want %v", got, want)
+	}
+}
+
+func TestParse(t *testing.T) {
+	tests := []struct {
+		name    string
+		input   string
+		wantNum int
+		wantDen int
+	}{
+		{"simple fraction", "3/4", 3, 4},
+		{"mixed number", "1 1/2", 3, 2},
+		{"negative mixed number", "-1 1/2", -3, 2},
+		{"bare integer", "5", 5, 1},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			num, den, err := Parse(tc.input)
+			if err != nil {
+				t.Fatalf("unexpected error: %v", err)
+			}
+			if num != tc.wantNum || den != tc.wantDen {
+				t.Fatalf("Parse(%q) = %d/%d, want %d/%d", tc.input, num, den, tc.wantNum, tc.wantDen)
+			}
+		})
+	}
+}
+
+func TestParseMalformed(t *testing.T) {
+	for _, s := range []string{"three quarters", "1/0abc", "1 2 3"} {
+		if _, _, err := Parse(s); err == nil {
+			t.Fatalf("Parse(%q): expected error", s)
+		}
+	}
+}
+
+func TestToDecimal(t *testing.T) {
+	got, err := ToDecimal(1, 2)
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	assertClose(t, got, 0.5, 1e-9)
+}
+
+func TestToDecimalZeroDenominator(t *testing.T) {
+	_, err := ToDecimal(1, 0)
+	if !errors.Is(err, ErrZeroDenominator) {
+		t.Fatalf("expected ErrZeroDenominator, got %v", err)
+	}
+}
+
+func TestFromDecimal(t *testing.T) {
+	tests := []struct {
+		name    string
+		value   float64
+		maxDen  int
+		wantNum int
+		wantDen int
+	}{
+		{"three quarters", 0.75, 100, 3, 4},
+		{"one and a half", 1.5, 100, 3, 2},
+		{"negative quarter", -0.25, 100, -1, 4},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			num, den := FromDecimal(tc.value, tc.maxDen)
+			if num != tc.wantNum || den != tc.wantDen {
+				t.Fatalf("FromDecimal(%v, %d) = %d/%d, want %d/%d", tc.value, tc.maxDen, num, den, tc.wantNum, tc.wantDen)
+			}
+		})
+	}
+}
+
+func TestParseThenToDecimal(t *testing.T) {
+	num, den, err := Parse("1 1/2")
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	got, err := ToDecimal(num, den)
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	assertClose(t, got, 1.5, 1e-9)
+}
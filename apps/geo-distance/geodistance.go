@@ -0,0 +1,74 @@
+// Package geodistance computes great-circle distance between two
+// latitude/longitude points using the Haversine formula.
+package geodistance
+
+import (
+	"fmt"
+	"math"
+
+	converter "github.com/richoandika/community-toolbox/apps/unit-converter"
+)
+
+// earthRadiusKm is the mean Earth radius used by the Haversine formula.
+// It trades the (tiny) error from treating Earth as a sphere for a
+// formula simple enough to run per-point with no ellipsoid model.
+const earthRadiusKm = 6371.0
+
+// feetPerMile relates feet and miles; there is no "mi" unit registered
+// in the converter package's default registry, so HaversineMiles goes
+// through ft instead.
+const feetPerMile = 5280.0
+
+// RangeError is returned by Validate when a latitude or longitude falls
+// outside its valid range.
+type RangeError struct {
+	Field string
+	Value float64
+}
+
+func (e *RangeError) Error() string {
+	return fmt.Sprintf("geodistance: %s %g is out of range", e.Field, e.Value)
+}
+
+// Validate checks that lat is within ±90 degrees and lon is within
+// ±180 degrees, returning a *RangeError naming the first field found
+// out of range.
+func Validate(lat, lon float64) error {
+	if lat < -90 || lat > 90 {
+		return &RangeError{Field: "latitude", Value: lat}
+	}
+	if lon < -180 || lon > 180 {
+		return &RangeError{Field: "longitude", Value: lon}
+	}
+	return nil
+}
+
+// Haversine returns the great-circle distance in kilometers between
+// (lat1, lon1) and (lat2, lon2), both in degrees.
+func Haversine(lat1, lon1, lat2, lon2 float64) float64 {
+	phi1 := lat1 * math.Pi / 180
+	phi2 := lat2 * math.Pi / 180
+	dPhi := (lat2 - lat1) * math.Pi / 180
+	dLambda := (lon2 - lon1) * math.Pi / 180
+
+	a := math.Sin(dPhi/2)*math.Sin(dPhi/2) +
+		math.Cos(phi1)*math.Cos(phi2)*math.Sin(dLambda/2)*math.Sin(dLambda/2)
+	c := 2 * math.Atan2(math.Sqrt(a), math.Sqrt(1-a))
+	return earthRadiusKm * c
+}
+
+// HaversineMiles is like Haversine but returns the distance in miles,
+// converting the kilometer result through the unit-converter package's
+// km->m->ft chain rather than hardcoding a separate km-to-mile factor.
+func HaversineMiles(lat1, lon1, lat2, lon2 float64) (float64, error) {
+	km := Haversine(lat1, lon1, lat2, lon2)
+	meters, err := converter.Convert("km2m", km)
+	if err != nil {
+		return 0, err
+	}
+	feet, err := converter.Convert("m2ft", meters)
+	if err != nil {
+		return 0, err
+	}
+	return feet / feetPerMile, nil
+}
@@ -0,0 +1,70 @@
+package geodistance
+
+import (
+	"math"
+	"testing"
+)
+
+func assertClose(t *testing.T, got, want, tol float64) {
+	t.Helper()
+	if math.Abs(got-want) > tol {
+		t.Fatalf("got %v, want %v", got, want)
+	}
+}
+
+func TestHaversine(t *testing.T) {
+	tests := []struct {
+		name string
+		lat1 float64
+		lon1 float64
+		lat2 float64
+		lon2 float64
+		want float64
+	}{
+		// London to Paris.
+		{"London-Paris", 51.5074, -0.1278, 48.8566, 2.3522, 343.6},
+		// New York to Los Angeles.
+		{"NYC-LA", 40.7128, -74.0060, 34.0522, -118.2437, 3936},
+		// A point to itself.
+		{"same point", 10, 20, 10, 20, 0},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			got := Haversine(tc.lat1, tc.lon1, tc.lat2, tc.lon2)
+			assertClose(t, got, tc.want, 1)
+		})
+	}
+}
+
+func TestHaversineMiles(t *testing.T) {
+	got, err := HaversineMiles(40.7128, -74.0060, 34.0522, -118.2437)
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	assertClose(t, got, 2446, 5)
+}
+
+func TestValidate(t *testing.T) {
+	tests := []struct {
+		name    string
+		lat     float64
+		lon     float64
+		wantErr bool
+	}{
+		{"valid", 45, 90, false},
+		{"lat too high", 91, 0, true},
+		{"lat too low", -91, 0, true},
+		{"lon too high", 0, 181, true},
+		{"lon too low", 0, -181, true},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			err := Validate(tc.lat, tc.lon)
+			if (err != nil) != tc.wantErr {
+				t.Fatalf("Validate(%v, %v) error = %v, wantErr %v", tc.lat, tc.lon, err, tc.wantErr)
+			}
+		})
+	}
+}
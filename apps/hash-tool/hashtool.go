@@ -0,0 +1,64 @@
+// Package hashtool computes checksums and cryptographic hashes of
+// strings and streams, using only algorithms in the standard library.
+package hashtool
+
+import (
+	"crypto/md5"
+	"crypto/sha1"
+	"crypto/sha256"
+	"encoding/hex"
+	"fmt"
+	"hash"
+	"hash/crc32"
+	"io"
+	"strings"
+)
+
+// UnknownAlgorithmError is returned when algo doesn't name a supported
+// hash algorithm.
+type UnknownAlgorithmError struct {
+	Algorithm string
+}
+
+func (e *UnknownAlgorithmError) Error() string {
+	return fmt.Sprintf("hashtool: unknown algorithm %q, want one of %s", e.Algorithm, strings.Join(Algorithms(), ", "))
+}
+
+// newHashers maps each supported algorithm name to a constructor for a
+// fresh hash.Hash, so HashString and HashReader share one lookup
+// instead of duplicating the switch.
+var newHashers = map[string]func() hash.Hash{
+	"md5":    md5.New,
+	"sha1":   sha1.New,
+	"sha256": sha256.New,
+	"crc32":  func() hash.Hash { return crc32.NewIEEE() },
+}
+
+// Algorithms returns the supported algorithm names, in a fixed order
+// suitable for listing in an error message or a CLI's usage text.
+func Algorithms() []string {
+	return []string{"md5", "sha1", "sha256", "crc32"}
+}
+
+// HashString returns the lowercase hex digest of input under algo
+// ("md5", "sha1", "sha256", or "crc32"). An unknown algo returns an
+// *UnknownAlgorithmError.
+func HashString(algo, input string) (string, error) {
+	return HashReader(algo, strings.NewReader(input))
+}
+
+// HashReader is like HashString but streams r through algo's hash
+// instead of requiring the whole input in memory, e.g. for hashing a
+// large file.
+func HashReader(algo string, r io.Reader) (string, error) {
+	newHasher, ok := newHashers[algo]
+	if !ok {
+		return "", &UnknownAlgorithmError{Algorithm: algo}
+	}
+
+	h := newHasher()
+	if _, err := io.Copy(h, r); err != nil {
+		return "", fmt.Errorf("hashtool: reading input: %w", err)
+	}
+	return hex.EncodeToString(h.Sum(nil)), nil
+}
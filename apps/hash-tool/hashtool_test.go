@@ -0,0 +1,46 @@
+package hashtool
+
+import (
+	"errors"
+	"strings"
+	"testing"
+)
+
+func TestHashStringKnownVectors(t *testing.T) {
+	tests := []struct {
+		algo string
+		want string
+	}{
+		{"md5", "900150983cd24fb0d6963f7d28e17f72"},
+		{"sha1", "a9993e364706816aba3e25717850c26c9cd0d89d"},
+		{"sha256", "ba7816bf8f01cfea414140de5dae2223b00361a396177a9cb410ff61f20015ad"},
+		{"crc32", "352441c2"},
+	}
+	for _, tc := range tests {
+		got, err := HashString(tc.algo, "abc")
+		if err != nil {
+			t.Fatalf("HashString(%q, \"abc\") returned error: %v", tc.algo, err)
+		}
+		if got != tc.want {
+			t.Errorf("HashString(%q, \"abc\") = %q, want %q", tc.algo, got, tc.want)
+		}
+	}
+}
+
+func TestHashReaderStreaming(t *testing.T) {
+	got, err := HashReader("sha256", strings.NewReader("abc"))
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	want := "ba7816bf8f01cfea414140de5dae2223b00361a396177a9cb410ff61f20015ad"
+	if got != want {
+		t.Fatalf("HashReader(sha256, \"abc\") = %q, want %q", got, want)
+	}
+}
+
+func TestHashStringUnknownAlgorithm(t *testing.T) {
+	var target *UnknownAlgorithmError
+	if _, err := HashString("sha512", "abc"); !errors.As(err, &target) {
+		t.Fatalf("expected *UnknownAlgorithmError, got %v", err)
+	}
+}
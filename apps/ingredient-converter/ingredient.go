@@ -0,0 +1,64 @@
+// Package ingredientconverter converts cooking measurements between
+// cups and grams per ingredient, since a cup of flour and a cup of
+// sugar weigh very different amounts. It builds naturally on the
+// unit-converter's US customary cooking-measurement units.
+package ingredientconverter
+
+import (
+	"fmt"
+	"sort"
+	"strings"
+)
+
+// UnknownIngredientError is returned when an ingredient isn't in the
+// density table. Known lists the ingredients that are.
+type UnknownIngredientError struct {
+	Ingredient string
+	Known      []string
+}
+
+func (e *UnknownIngredientError) Error() string {
+	return fmt.Sprintf("ingredientconverter: unknown ingredient %q, supported ingredients: %s",
+		e.Ingredient, strings.Join(e.Known, ", "))
+}
+
+// gramsPerCup holds each ingredient's density in grams per US
+// customary cup, keyed by lower-cased name.
+var gramsPerCup = map[string]float64{
+	"flour":  120,
+	"sugar":  200,
+	"butter": 227,
+}
+
+// CupsToGrams converts cups of ingredient to grams using its density.
+// ingredient is matched case-insensitively.
+func CupsToGrams(ingredient string, cups float64) (float64, error) {
+	density, err := lookupDensity(ingredient)
+	if err != nil {
+		return 0, err
+	}
+	return cups * density, nil
+}
+
+// GramsToCups converts grams of ingredient to cups using its density.
+// ingredient is matched case-insensitively.
+func GramsToCups(ingredient string, grams float64) (float64, error) {
+	density, err := lookupDensity(ingredient)
+	if err != nil {
+		return 0, err
+	}
+	return grams / density, nil
+}
+
+func lookupDensity(ingredient string) (float64, error) {
+	density, ok := gramsPerCup[strings.ToLower(ingredient)]
+	if !ok {
+		known := make([]string, 0, len(gramsPerCup))
+		for name := range gramsPerCup {
+			known = append(known, name)
+		}
+		sort.Strings(known)
+		return 0, &UnknownIngredientError{Ingredient: ingredient, Known: known}
+	}
+	return density, nil
+}
@@ -0,0 +1,58 @@
+package ingredientconverter
+
+import (
+	"math"
+	"testing"
+)
+
+func assertClose(t *testing.T, got, want, tol float64) {
+	t.Helper()
+	if math.Abs(got-want) > tol {
+		t.Fatalf("got %v, want %v", got, want)
+	}
+}
+
+func TestCupsToGrams(t *testing.T) {
+	tests := []struct {
+		name       string
+		ingredient string
+		cups       float64
+		want       float64
+	}{
+		{"flour", "flour", 2, 240},
+		{"sugar", "sugar", 1.5, 300},
+		{"case insensitive", "FLOUR", 1, 120},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			got, err := CupsToGrams(tc.ingredient, tc.cups)
+			if err != nil {
+				t.Fatalf("unexpected error: %v", err)
+			}
+			assertClose(t, got, tc.want, 1e-9)
+		})
+	}
+}
+
+func TestGramsToCups(t *testing.T) {
+	got, err := GramsToCups("sugar", 300)
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	assertClose(t, got, 1.5, 1e-9)
+}
+
+func TestUnknownIngredient(t *testing.T) {
+	_, err := CupsToGrams("kale", 1)
+	if err == nil {
+		t.Fatal("expected error for unknown ingredient")
+	}
+	unknownErr, ok := err.(*UnknownIngredientError)
+	if !ok {
+		t.Fatalf("expected *UnknownIngredientError, got %T", err)
+	}
+	if len(unknownErr.Known) == 0 {
+		t.Fatal("expected Known to list supported ingredients")
+	}
+}
@@ -0,0 +1,97 @@
+// Package iptools provides small utilities for inspecting IP address
+// ranges expressed in CIDR notation, supporting both IPv4 and IPv6.
+package iptools
+
+import (
+	"fmt"
+	"net/netip"
+)
+
+// InvalidCIDRError is returned when a CIDR string isn't valid, e.g.
+// malformed or missing its prefix length.
+type InvalidCIDRError struct {
+	CIDR string
+}
+
+func (e *InvalidCIDRError) Error() string {
+	return fmt.Sprintf("iptools: %q is not a valid CIDR", e.CIDR)
+}
+
+// InvalidIPError is returned when an IP address string isn't valid.
+type InvalidIPError struct {
+	IP string
+}
+
+func (e *InvalidIPError) Error() string {
+	return fmt.Sprintf("iptools: %q is not a valid IP address", e.IP)
+}
+
+// HostCount returns the number of addresses contained in cidr,
+// including the network and broadcast addresses, e.g. HostCount for a
+// /24 IPv4 prefix is 256. The count for large IPv6 prefixes can exceed
+// what a uint64 can hold; HostCount saturates at math.MaxUint64 rather
+// than overflowing.
+func HostCount(cidr string) (uint64, error) {
+	prefix, err := netip.ParsePrefix(cidr)
+	if err != nil {
+		return 0, &InvalidCIDRError{CIDR: cidr}
+	}
+	hostBits := prefix.Addr().BitLen() - prefix.Bits()
+	if hostBits >= 64 {
+		return ^uint64(0), nil
+	}
+	return uint64(1) << hostBits, nil
+}
+
+// Contains reports whether ip falls within cidr.
+func Contains(cidr, ip string) (bool, error) {
+	prefix, err := netip.ParsePrefix(cidr)
+	if err != nil {
+		return false, &InvalidCIDRError{CIDR: cidr}
+	}
+	addr, err := netip.ParseAddr(ip)
+	if err != nil {
+		return false, &InvalidIPError{IP: ip}
+	}
+	return prefix.Contains(addr), nil
+}
+
+// IPRange returns the first and last addresses contained in cidr, e.g.
+// IPRange for "192.168.0.0/24" returns "192.168.0.0" and
+// "192.168.0.255".
+func IPRange(cidr string) (first, last string, err error) {
+	prefix, err := netip.ParsePrefix(cidr)
+	if err != nil {
+		return "", "", &InvalidCIDRError{CIDR: cidr}
+	}
+	network := prefix.Masked().Addr()
+
+	bytes := network.As16()
+	if network.Is4() {
+		bytes4 := network.As4()
+		setHostBitsHigh(bytes4[:], prefix.Bits())
+		last := netip.AddrFrom4(bytes4)
+		return network.String(), last.String(), nil
+	}
+	setHostBitsHigh(bytes[:], prefix.Bits())
+	return network.String(), netip.AddrFrom16(bytes).String(), nil
+}
+
+// setHostBitsHigh sets every bit after the first prefixBits bits of b
+// to 1, turning a network address into the broadcast-style last
+// address of its prefix.
+func setHostBitsHigh(b []byte, prefixBits int) {
+	for i := range b {
+		bitOffset := i * 8
+		switch {
+		case bitOffset+8 <= prefixBits:
+			continue
+		case bitOffset >= prefixBits:
+			b[i] = 0xff
+		default:
+			keep := prefixBits - bitOffset
+			mask := byte(0xff) >> keep
+			b[i] |= mask
+		}
+	}
+}
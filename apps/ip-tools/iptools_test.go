@@ -0,0 +1,100 @@
+package iptools
+
+import (
+	"errors"
+	"testing"
+)
+
+func TestHostCount(t *testing.T) {
+	tests := []struct {
+		cidr string
+		want uint64
+	}{
+		{"192.168.0.0/24", 256},
+		{"192.168.0.0/32", 1},
+		{"10.0.0.0/8", 16777216},
+	}
+	for _, tc := range tests {
+		got, err := HostCount(tc.cidr)
+		if err != nil {
+			t.Fatalf("HostCount(%q) returned error: %v", tc.cidr, err)
+		}
+		if got != tc.want {
+			t.Errorf("HostCount(%q) = %d, want %d", tc.cidr, got, tc.want)
+		}
+	}
+}
+
+func TestHostCountLargeIPv6Saturates(t *testing.T) {
+	got, err := HostCount("2001:db8::/32")
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if got != ^uint64(0) {
+		t.Errorf("HostCount(2001:db8::/32) = %d, want saturated max uint64", got)
+	}
+}
+
+func TestHostCountInvalidCIDR(t *testing.T) {
+	var target *InvalidCIDRError
+	if _, err := HostCount("not-a-cidr"); !errors.As(err, &target) {
+		t.Fatalf("expected *InvalidCIDRError, got %v", err)
+	}
+}
+
+func TestContains(t *testing.T) {
+	tests := []struct {
+		cidr string
+		ip   string
+		want bool
+	}{
+		{"192.168.0.0/24", "192.168.0.42", true},
+		{"192.168.0.0/24", "192.168.1.1", false},
+		{"2001:db8::/32", "2001:db8::1", true},
+		{"2001:db8::/32", "2001:db9::1", false},
+	}
+	for _, tc := range tests {
+		got, err := Contains(tc.cidr, tc.ip)
+		if err != nil {
+			t.Fatalf("Contains(%q, %q) returned error: %v", tc.cidr, tc.ip, err)
+		}
+		if got != tc.want {
+			t.Errorf("Contains(%q, %q) = %v, want %v", tc.cidr, tc.ip, got, tc.want)
+		}
+	}
+}
+
+func TestContainsInvalidIP(t *testing.T) {
+	var target *InvalidIPError
+	if _, err := Contains("192.168.0.0/24", "not-an-ip"); !errors.As(err, &target) {
+		t.Fatalf("expected *InvalidIPError, got %v", err)
+	}
+}
+
+func TestIPRange(t *testing.T) {
+	tests := []struct {
+		cidr      string
+		wantFirst string
+		wantLast  string
+	}{
+		{"192.168.0.0/24", "192.168.0.0", "192.168.0.255"},
+		{"192.168.0.0/32", "192.168.0.0", "192.168.0.0"},
+		{"2001:db8::/126", "2001:db8::", "2001:db8::3"},
+	}
+	for _, tc := range tests {
+		first, last, err := IPRange(tc.cidr)
+		if err != nil {
+			t.Fatalf("IPRange(%q) returned error: %v", tc.cidr, err)
+		}
+		if first != tc.wantFirst || last != tc.wantLast {
+			t.Errorf("IPRange(%q) = (%q, %q), want (%q, %q)", tc.cidr, first, last, tc.wantFirst, tc.wantLast)
+		}
+	}
+}
+
+func TestIPRangeInvalidCIDR(t *testing.T) {
+	var target *InvalidCIDRError
+	if _, _, err := IPRange("not-a-cidr"); !errors.As(err, &target) {
+		t.Fatalf("expected *InvalidCIDRError, got %v", err)
+	}
+}
@@ -0,0 +1,127 @@
+// Package jsondiff computes a structural diff between two JSON
+// documents, reporting which values were added, removed, or changed
+// and where, without caring about object key order.
+package jsondiff
+
+import (
+	"encoding/json"
+	"fmt"
+)
+
+// ParseError is returned when a or b passed to Diff isn't valid JSON.
+type ParseError struct {
+	Which string // "a" or "b"
+	Err   error
+}
+
+func (e *ParseError) Error() string {
+	return fmt.Sprintf("jsondiff: invalid JSON in %s: %v", e.Which, e.Err)
+}
+
+func (e *ParseError) Unwrap() error {
+	return e.Err
+}
+
+// ChangeKind identifies what happened at a Change's Path.
+type ChangeKind string
+
+const (
+	Added   ChangeKind = "added"
+	Removed ChangeKind = "removed"
+	Changed ChangeKind = "changed"
+)
+
+// Change describes a single difference between two JSON documents.
+// Path uses dot notation for object fields and bracket notation for
+// array indexes, e.g. "items[2].name". OldValue is nil for Added,
+// NewValue is nil for Removed.
+type Change struct {
+	Path     string
+	Kind     ChangeKind
+	OldValue interface{}
+	NewValue interface{}
+}
+
+// Diff parses a and b as JSON and returns every structural difference
+// between them, in no particular order. Object key order never
+// produces a difference; only the set of keys and their values do.
+func Diff(a, b []byte) ([]Change, error) {
+	var va, vb interface{}
+	if err := json.Unmarshal(a, &va); err != nil {
+		return nil, &ParseError{Which: "a", Err: err}
+	}
+	if err := json.Unmarshal(b, &vb); err != nil {
+		return nil, &ParseError{Which: "b", Err: err}
+	}
+
+	var changes []Change
+	diffValues("", va, vb, &changes)
+	return changes, nil
+}
+
+func diffValues(path string, a, b interface{}, changes *[]Change) {
+	am, aIsMap := a.(map[string]interface{})
+	bm, bIsMap := b.(map[string]interface{})
+	if aIsMap && bIsMap {
+		diffMaps(path, am, bm, changes)
+		return
+	}
+
+	as, aIsSlice := a.([]interface{})
+	bs, bIsSlice := b.([]interface{})
+	if aIsSlice && bIsSlice {
+		diffSlices(path, as, bs, changes)
+		return
+	}
+
+	if !valuesEqual(a, b) {
+		*changes = append(*changes, Change{Path: path, Kind: Changed, OldValue: a, NewValue: b})
+	}
+}
+
+func diffMaps(path string, a, b map[string]interface{}, changes *[]Change) {
+	for key, av := range a {
+		childPath := joinField(path, key)
+		bv, ok := b[key]
+		if !ok {
+			*changes = append(*changes, Change{Path: childPath, Kind: Removed, OldValue: av})
+			continue
+		}
+		diffValues(childPath, av, bv, changes)
+	}
+	for key, bv := range b {
+		if _, ok := a[key]; !ok {
+			*changes = append(*changes, Change{Path: joinField(path, key), Kind: Added, NewValue: bv})
+		}
+	}
+}
+
+func diffSlices(path string, a, b []interface{}, changes *[]Change) {
+	for i := 0; i < len(a) || i < len(b); i++ {
+		childPath := fmt.Sprintf("%s[%d]", path, i)
+		switch {
+		case i >= len(a):
+			*changes = append(*changes, Change{Path: childPath, Kind: Added, NewValue: b[i]})
+		case i >= len(b):
+			*changes = append(*changes, Change{Path: childPath, Kind: Removed, OldValue: a[i]})
+		default:
+			diffValues(childPath, a[i], b[i], changes)
+		}
+	}
+}
+
+func joinField(path, key string) string {
+	if path == "" {
+		return key
+	}
+	return path + "." + key
+}
+
+func valuesEqual(a, b interface{}) bool {
+	aj, aErr := json.Marshal(a)
+	bj, bErr := json.Marshal(b)
+	if aErr != nil || bErr != nil {
+		return false
+	}
+	return string(aj) == string(bj)
+}
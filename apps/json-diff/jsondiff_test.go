@@ -0,0 +1,108 @@
+package jsondiff
+
+import (
+	"errors"
+	"sort"
+	"testing"
+)
+
+func changePaths(changes []Change) []string {
+	paths := make([]string, len(changes))
+	for i, c := range changes {
+		paths[i] = string(c.Kind) + ":" + c.Path
+	}
+	sort.Strings(paths)
+	return paths
+}
+
+func TestDiffAddedField(t *testing.T) {
+	changes, err := Diff([]byte(`{"name":"ada"}`), []byte(`{"name":"ada","age":30}`))
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if got, want := changePaths(changes), []string{"added:age"}; !equalStrings(got, want) {
+		t.Fatalf("paths = %v, want %v", got, want)
+	}
+}
+
+func TestDiffRemovedField(t *testing.T) {
+	changes, err := Diff([]byte(`{"name":"ada","age":30}`), []byte(`{"name":"ada"}`))
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if got, want := changePaths(changes), []string{"removed:age"}; !equalStrings(got, want) {
+		t.Fatalf("paths = %v, want %v", got, want)
+	}
+}
+
+func TestDiffChangedValue(t *testing.T) {
+	changes, err := Diff([]byte(`{"name":"ada"}`), []byte(`{"name":"grace"}`))
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if len(changes) != 1 || changes[0].Kind != Changed || changes[0].Path != "name" {
+		t.Fatalf("changes = %+v, want one Changed at \"name\"", changes)
+	}
+	if changes[0].OldValue != "ada" || changes[0].NewValue != "grace" {
+		t.Fatalf("changes[0] = %+v, want old=ada new=grace", changes[0])
+	}
+}
+
+func TestDiffKeyOrderDoesNotMatter(t *testing.T) {
+	changes, err := Diff([]byte(`{"a":1,"b":2}`), []byte(`{"b":2,"a":1}`))
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if len(changes) != 0 {
+		t.Fatalf("changes = %+v, want none", changes)
+	}
+}
+
+func TestDiffNestedObject(t *testing.T) {
+	changes, err := Diff(
+		[]byte(`{"address":{"city":"nyc","zip":"10001"}}`),
+		[]byte(`{"address":{"city":"boston","zip":"10001"}}`),
+	)
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if got, want := changePaths(changes), []string{"changed:address.city"}; !equalStrings(got, want) {
+		t.Fatalf("paths = %v, want %v", got, want)
+	}
+}
+
+func TestDiffArrayChanges(t *testing.T) {
+	changes, err := Diff(
+		[]byte(`{"items":[{"name":"a"},{"name":"b"}]}`),
+		[]byte(`{"items":[{"name":"a"},{"name":"c"},{"name":"d"}]}`),
+	)
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	got, want := changePaths(changes), []string{"added:items[2]", "changed:items[1].name"}
+	if !equalStrings(got, want) {
+		t.Fatalf("paths = %v, want %v", got, want)
+	}
+}
+
+func TestDiffMalformedInput(t *testing.T) {
+	var target *ParseError
+	if _, err := Diff([]byte(`{bad`), []byte(`{}`)); !errors.As(err, &target) {
+		t.Fatalf("expected *ParseError, got %v", err)
+	}
+	if _, err := Diff([]byte(`{}`), []byte(`{bad`)); !errors.As(err, &target) {
+		t.Fatalf("expected *ParseError, got %v", err)
+	}
+}
+
+func equalStrings(a, b []string) bool {
+	if len(a) != len(b) {
+		return false
+	}
+	for i := range a {
+		if a[i] != b[i] {
+			return false
+		}
+	}
+	return true
+}
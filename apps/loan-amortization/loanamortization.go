@@ -0,0 +1,85 @@
+// Package loanamortization computes month-by-month amortization
+// schedules for a fixed-rate loan.
+package loanamortization
+
+import (
+	"fmt"
+	"math"
+)
+
+// InvalidPrincipalError is returned when principal isn't positive.
+type InvalidPrincipalError struct {
+	Principal float64
+}
+
+func (e *InvalidPrincipalError) Error() string {
+	return fmt.Sprintf("loanamortization: principal must be positive, got %g", e.Principal)
+}
+
+// InvalidMonthsError is returned when months isn't positive.
+type InvalidMonthsError struct {
+	Months int
+}
+
+func (e *InvalidMonthsError) Error() string {
+	return fmt.Sprintf("loanamortization: months must be positive, got %d", e.Months)
+}
+
+// Payment describes one month of an amortization schedule.
+type Payment struct {
+	Number    int
+	Payment   float64
+	Principal float64
+	Interest  float64
+	Balance   float64
+}
+
+// Schedule computes the month-by-month amortization schedule for a
+// loan of principal, at annualRatePct (e.g. 5 for 5%), repaid in equal
+// payments over months. A zero annualRatePct produces equal principal
+// payments with no interest. Rounding is absorbed into the final
+// payment so the last Balance is exactly zero. principal and months
+// must both be positive.
+func Schedule(principal, annualRatePct float64, months int) ([]Payment, error) {
+	if principal <= 0 {
+		return nil, &InvalidPrincipalError{Principal: principal}
+	}
+	if months <= 0 {
+		return nil, &InvalidMonthsError{Months: months}
+	}
+
+	monthlyRate := annualRatePct / 100 / 12
+
+	var payment float64
+	if monthlyRate == 0 {
+		payment = principal / float64(months)
+	} else {
+		factor := math.Pow(1+monthlyRate, float64(months))
+		payment = principal * monthlyRate * factor / (factor - 1)
+	}
+
+	schedule := make([]Payment, months)
+	balance := principal
+	for i := 0; i < months; i++ {
+		interest := balance * monthlyRate
+		principalPaid := payment - interest
+		thisPayment := payment
+
+		if i == months-1 {
+			principalPaid = balance
+			thisPayment = principalPaid + interest
+			balance = 0
+		} else {
+			balance -= principalPaid
+		}
+
+		schedule[i] = Payment{
+			Number:    i + 1,
+			Payment:   thisPayment,
+			Principal: principalPaid,
+			Interest:  interest,
+			Balance:   balance,
+		}
+	}
+	return schedule, nil
+}
@@ -0,0 +1,78 @@
+package loanamortization
+
+import (
+	"errors"
+	"math"
+	"testing"
+)
+
+func assertClose(t *testing.T, got, want, tolerance float64) {
+	t.Helper()
+	if math.Abs(got-want) > tolerance {
+		t.Errorf("got %g, want %g (tolerance %g)", got, want, tolerance)
+	}
+}
+
+func TestScheduleKnownPayment(t *testing.T) {
+	schedule, err := Schedule(100000, 6, 360)
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	assertClose(t, schedule[0].Payment, 599.5505251527569, 1e-6)
+}
+
+func TestScheduleLength(t *testing.T) {
+	schedule, err := Schedule(10000, 5, 24)
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if len(schedule) != 24 {
+		t.Fatalf("len(schedule) = %d, want 24", len(schedule))
+	}
+	for i, p := range schedule {
+		if p.Number != i+1 {
+			t.Errorf("schedule[%d].Number = %d, want %d", i, p.Number, i+1)
+		}
+	}
+}
+
+func TestScheduleFinalBalanceIsZero(t *testing.T) {
+	schedule, err := Schedule(123456.78, 4.5, 180)
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	last := schedule[len(schedule)-1]
+	if last.Balance != 0 {
+		t.Errorf("final balance = %g, want exactly 0", last.Balance)
+	}
+}
+
+func TestScheduleZeroInterest(t *testing.T) {
+	schedule, err := Schedule(1200, 0, 12)
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	for i, p := range schedule {
+		if p.Interest != 0 {
+			t.Errorf("schedule[%d].Interest = %g, want 0", i, p.Interest)
+		}
+		assertClose(t, p.Principal, 100, 1e-9)
+	}
+	if schedule[len(schedule)-1].Balance != 0 {
+		t.Errorf("final balance = %g, want 0", schedule[len(schedule)-1].Balance)
+	}
+}
+
+func TestScheduleInvalidPrincipal(t *testing.T) {
+	var target *InvalidPrincipalError
+	if _, err := Schedule(0, 5, 12); !errors.As(err, &target) {
+		t.Fatalf("expected *InvalidPrincipalError, got %v", err)
+	}
+}
+
+func TestScheduleInvalidMonths(t *testing.T) {
+	var target *InvalidMonthsError
+	if _, err := Schedule(1000, 5, 0); !errors.As(err, &target) {
+		t.Fatalf("expected *InvalidMonthsError, got %v", err)
+	}
+}
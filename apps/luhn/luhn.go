@@ -0,0 +1,85 @@
+// Package luhn implements the Luhn checksum algorithm used to
+// validate credit-card-style numbers.
+package luhn
+
+import "fmt"
+
+// InvalidCharacterError is returned when a number string contains a
+// character that isn't a digit, space, or hyphen.
+type InvalidCharacterError struct {
+	Char rune
+}
+
+func (e *InvalidCharacterError) Error() string {
+	return fmt.Sprintf("luhn: invalid character %q", e.Char)
+}
+
+// EmptyInputError is returned when a number string has no digits left
+// after stripping spaces and hyphens.
+var EmptyInputError = fmt.Errorf("luhn: input has no digits")
+
+// clean strips spaces and hyphens from number and returns its digits,
+// or an error if any other character remains.
+func clean(number string) ([]int, error) {
+	var digits []int
+	for _, r := range number {
+		switch {
+		case r == ' ' || r == '-':
+			continue
+		case r >= '0' && r <= '9':
+			digits = append(digits, int(r-'0'))
+		default:
+			return nil, &InvalidCharacterError{Char: r}
+		}
+	}
+	if len(digits) == 0 {
+		return nil, EmptyInputError
+	}
+	return digits, nil
+}
+
+// checksum returns the Luhn checksum digit sum of digits, doubling
+// every second digit from the right and subtracting 9 from any result
+// over 9.
+func checksum(digits []int) int {
+	sum := 0
+	for i, d := range digits {
+		// Position is counted from the right: the rightmost digit is
+		// position 0 and is never doubled.
+		if (len(digits)-1-i)%2 == 1 {
+			d *= 2
+			if d > 9 {
+				d -= 9
+			}
+		}
+		sum += d
+	}
+	return sum
+}
+
+// Validate reports whether number (a string of digits, optionally
+// containing spaces and hyphens as separators) satisfies the Luhn
+// checksum, the check digit scheme used by credit card and IMEI
+// numbers among others.
+func Validate(number string) (bool, error) {
+	digits, err := clean(number)
+	if err != nil {
+		return false, err
+	}
+	return checksum(digits)%10 == 0, nil
+}
+
+// CheckDigit computes the digit that, appended to partial, makes the
+// resulting number satisfy the Luhn checksum.
+func CheckDigit(partial string) (int, error) {
+	digits, err := clean(partial)
+	if err != nil {
+		return 0, err
+	}
+	// Appending a digit shifts every existing digit one position to
+	// the left (away from position 0), flipping which ones get
+	// doubled, so compute the checksum as if a 0 were already
+	// appended and solve for what the new digit must contribute.
+	sum := checksum(append(digits, 0))
+	return (10 - sum%10) % 10, nil
+}
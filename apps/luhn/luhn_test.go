@@ -0,0 +1,66 @@
+package luhn
+
+import (
+	"errors"
+	"testing"
+)
+
+func TestValidate(t *testing.T) {
+	tests := []struct {
+		number string
+		want   bool
+	}{
+		{"79927398713", true},
+		{"79927398710", false},
+		{"4532015112830366", true},
+		{"4532-0151-1283-0366", true},
+		{"4532 0151 1283 0366", true},
+	}
+	for _, tc := range tests {
+		got, err := Validate(tc.number)
+		if err != nil {
+			t.Fatalf("Validate(%q) returned unexpected error: %v", tc.number, err)
+		}
+		if got != tc.want {
+			t.Errorf("Validate(%q) = %v, want %v", tc.number, got, tc.want)
+		}
+	}
+}
+
+func TestValidateInvalidCharacter(t *testing.T) {
+	var target *InvalidCharacterError
+	if _, err := Validate("4532a15112830366"); !errors.As(err, &target) {
+		t.Fatalf("expected *InvalidCharacterError, got %v", err)
+	}
+}
+
+func TestValidateEmptyInput(t *testing.T) {
+	if _, err := Validate("  - -"); !errors.Is(err, EmptyInputError) {
+		t.Fatalf("expected EmptyInputError, got %v", err)
+	}
+}
+
+func TestCheckDigit(t *testing.T) {
+	digit, err := CheckDigit("7992739871")
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if digit != 3 {
+		t.Fatalf("CheckDigit(\"7992739871\") = %d, want 3", digit)
+	}
+
+	valid, err := Validate("7992739871" + "3")
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if !valid {
+		t.Fatal("expected appending the computed check digit to validate")
+	}
+}
+
+func TestCheckDigitInvalidCharacter(t *testing.T) {
+	var target *InvalidCharacterError
+	if _, err := CheckDigit("799273987x"); !errors.As(err, &target) {
+		t.Fatalf("expected *InvalidCharacterError, got %v", err)
+	}
+}
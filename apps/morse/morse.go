@@ -0,0 +1,104 @@
+// Package morse converts text to and from International Morse code,
+// covering A-Z, 0-9, and common punctuation. Letters within a word are
+// separated by a single space and words are separated by " / ".
+package morse
+
+import (
+	"fmt"
+	"strings"
+)
+
+// InvalidCharacterError is returned by ToMorse when text contains a
+// character with no Morse encoding.
+type InvalidCharacterError struct {
+	Character rune
+}
+
+func (e *InvalidCharacterError) Error() string {
+	return fmt.Sprintf("morse: no Morse encoding for character %q", e.Character)
+}
+
+// InvalidCodeError is returned by FromMorse when code contains a
+// letter-separated token that isn't a known Morse sequence.
+type InvalidCodeError struct {
+	Code string
+}
+
+func (e *InvalidCodeError) Error() string {
+	return fmt.Sprintf("morse: %q is not a valid Morse code sequence", e.Code)
+}
+
+// toCode maps each supported uppercase letter, digit, or punctuation
+// mark to its Morse sequence of dots and dashes.
+var toCode = map[rune]string{
+	'A': ".-", 'B': "-...", 'C': "-.-.", 'D': "-..", 'E': ".",
+	'F': "..-.", 'G': "--.", 'H': "....", 'I': "..", 'J': ".---",
+	'K': "-.-", 'L': ".-..", 'M': "--", 'N': "-.", 'O': "---",
+	'P': ".--.", 'Q': "--.-", 'R': ".-.", 'S': "...", 'T': "-",
+	'U': "..-", 'V': "...-", 'W': ".--", 'X': "-..-", 'Y': "-.--",
+	'Z': "--..",
+	'0': "-----", '1': ".----", '2': "..---", '3': "...--", '4': "....-",
+	'5': ".....", '6': "-....", '7': "--...", '8': "---..", '9': "----.",
+	'.': ".-.-.-", ',': "--..--", '?': "..--..", '\'': ".----.",
+	'!': "-.-.--", '/': "-..-.", '(': "-.--.", ')': "-.--.-",
+	'&': ".-...", ':': "---...", ';': "-.-.-.", '=': "-...-",
+	'+': ".-.-.", '-': "-....-", '_': "..--.-", '"': ".-..-.",
+	'$': "...-..-", '@': ".--.-.",
+}
+
+// fromCode is toCode inverted, used by FromMorse to look up a Morse
+// sequence's character.
+var fromCode = func() map[string]rune {
+	m := make(map[string]rune, len(toCode))
+	for r, code := range toCode {
+		m[code] = r
+	}
+	return m
+}()
+
+// ToMorse encodes text as International Morse code. Letters are
+// case-insensitive; letters within a word are separated by a single
+// space and words are separated by " / ", e.g. ToMorse("HI") returns
+// ".... ..". A character with no Morse encoding returns an
+// *InvalidCharacterError.
+func ToMorse(text string) (string, error) {
+	words := strings.Fields(text)
+	encodedWords := make([]string, 0, len(words))
+	for _, word := range words {
+		letters := make([]string, 0, len(word))
+		for _, r := range strings.ToUpper(word) {
+			code, ok := toCode[r]
+			if !ok {
+				return "", &InvalidCharacterError{Character: r}
+			}
+			letters = append(letters, code)
+		}
+		encodedWords = append(encodedWords, strings.Join(letters, " "))
+	}
+	return strings.Join(encodedWords, " / "), nil
+}
+
+// FromMorse decodes International Morse code produced by ToMorse back
+// into text, rendered in uppercase since Morse carries no case
+// information. An unrecognized letter sequence returns an
+// *InvalidCodeError.
+func FromMorse(code string) (string, error) {
+	words := strings.Split(code, "/")
+	decodedWords := make([]string, 0, len(words))
+	for _, word := range words {
+		word = strings.TrimSpace(word)
+		if word == "" {
+			continue
+		}
+		var sb strings.Builder
+		for _, letter := range strings.Fields(word) {
+			r, ok := fromCode[letter]
+			if !ok {
+				return "", &InvalidCodeError{Code: letter}
+			}
+			sb.WriteRune(r)
+		}
+		decodedWords = append(decodedWords, sb.String())
+	}
+	return strings.Join(decodedWords, " "), nil
+}
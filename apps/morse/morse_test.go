@@ -0,0 +1,79 @@
+package morse
+
+import (
+	"errors"
+	"testing"
+)
+
+func TestToMorse(t *testing.T) {
+	got, err := ToMorse("HELLO WORLD")
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	want := ".... . .-.. .-.. --- / .-- --- .-. .-.. -.."
+	if got != want {
+		t.Fatalf("ToMorse(%q) = %q, want %q", "HELLO WORLD", got, want)
+	}
+}
+
+func TestToMorseCaseInsensitive(t *testing.T) {
+	got, err := ToMorse("hello")
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	want := ".... . .-.. .-.. ---"
+	if got != want {
+		t.Fatalf("ToMorse(%q) = %q, want %q", "hello", got, want)
+	}
+}
+
+func TestToMorsePunctuation(t *testing.T) {
+	got, err := ToMorse("OK?")
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	want := "--- -.- ..--.."
+	if got != want {
+		t.Fatalf("ToMorse(%q) = %q, want %q", "OK?", got, want)
+	}
+}
+
+func TestToMorseInvalidCharacter(t *testing.T) {
+	var target *InvalidCharacterError
+	if _, err := ToMorse("HELLO#"); !errors.As(err, &target) {
+		t.Fatalf("expected *InvalidCharacterError, got %v", err)
+	}
+}
+
+func TestFromMorse(t *testing.T) {
+	got, err := FromMorse(".... . .-.. .-.. --- / .-- --- .-. .-.. -..")
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	want := "HELLO WORLD"
+	if got != want {
+		t.Fatalf("FromMorse(...) = %q, want %q", got, want)
+	}
+}
+
+func TestFromMorseInvalidCode(t *testing.T) {
+	var target *InvalidCodeError
+	if _, err := FromMorse(".......-"); !errors.As(err, &target) {
+		t.Fatalf("expected *InvalidCodeError, got %v", err)
+	}
+}
+
+func TestRoundTrip(t *testing.T) {
+	const text = "HELLO WORLD"
+	code, err := ToMorse(text)
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	got, err := FromMorse(code)
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if got != text {
+		t.Fatalf("round trip = %q, want %q", got, text)
+	}
+}
@@ -0,0 +1,86 @@
+// Package notefrequency converts between musical note names and their
+// equal-temperament frequency, using A4 = 440 Hz and scientific pitch
+// notation (middle C is C4) as the reference.
+package notefrequency
+
+import (
+	"fmt"
+	"math"
+)
+
+// UnknownNoteError is returned when a note name isn't one of the twelve
+// chromatic notes.
+type UnknownNoteError struct {
+	Note string
+}
+
+func (e *UnknownNoteError) Error() string {
+	return fmt.Sprintf("notefrequency: unknown note %q", e.Note)
+}
+
+// NonPositiveFrequencyError is returned when a frequency passed to
+// FreqToNote is zero or negative.
+type NonPositiveFrequencyError struct {
+	Freq float64
+}
+
+func (e *NonPositiveFrequencyError) Error() string {
+	return fmt.Sprintf("notefrequency: frequency must be positive, got %g", e.Freq)
+}
+
+// semitonesFromC maps every note spelling (sharps and flats) to its
+// semitone offset from C within an octave.
+var semitonesFromC = map[string]int{
+	"C":  0,
+	"C#": 1, "Db": 1,
+	"D":  2,
+	"D#": 3, "Eb": 3,
+	"E":  4,
+	"F":  5,
+	"F#": 6, "Gb": 6,
+	"G":  7,
+	"G#": 8, "Ab": 8,
+	"A":  9,
+	"A#": 10, "Bb": 10,
+	"B": 11,
+}
+
+// noteNames lists the canonical (sharp) spelling for each semitone
+// offset from C, used by FreqToNote to render a note name back out.
+var noteNames = [12]string{"C", "C#", "D", "D#", "E", "F", "F#", "G", "G#", "A", "A#", "B"}
+
+// a4MIDI is the MIDI note number of A4 (440 Hz) in scientific pitch
+// notation, where middle C is C4 (MIDI 60).
+const a4MIDI = 69
+
+// NoteToFreq returns the equal-temperament frequency in Hz for note in
+// the given octave, e.g. NoteToFreq("A", 4) returns 440 and
+// NoteToFreq("C#", 5) returns the frequency a semitone above C5. note
+// accepts both sharp ("C#") and flat ("Db") spellings; an unrecognized
+// name returns an *UnknownNoteError.
+func NoteToFreq(note string, octave int) (float64, error) {
+	offset, ok := semitonesFromC[note]
+	if !ok {
+		return 0, &UnknownNoteError{Note: note}
+	}
+	midi := 12*(octave+1) + offset
+	return 440 * math.Pow(2, float64(midi-a4MIDI)/12), nil
+}
+
+// FreqToNote returns the nearest equal-temperament note and octave to
+// freq, plus cents (hundredths of a semitone) describing how far freq
+// deviates from that note: positive means freq is sharp of the note,
+// negative means flat. freq must be positive.
+func FreqToNote(freq float64) (note string, octave int, cents float64, err error) {
+	if freq <= 0 {
+		return "", 0, 0, &NonPositiveFrequencyError{Freq: freq}
+	}
+
+	exactMIDI := a4MIDI + 12*math.Log2(freq/440)
+	midi := math.Round(exactMIDI)
+	cents = (exactMIDI - midi) * 100
+
+	offset := int(midi) - int(math.Floor(midi/12))*12
+	octave = int(math.Floor(midi/12)) - 1
+	return noteNames[offset], octave, cents, nil
+}
@@ -0,0 +1,101 @@
+package notefrequency
+
+import (
+	"errors"
+	"math"
+	"testing"
+)
+
+func assertClose(t *testing.T, got, want, tol float64) {
+	t.Helper()
+	if math.Abs(got-want) > tol {
+		t.Fatalf("got %v, want %v", got, want)
+	}
+}
+
+func TestNoteToFreq(t *testing.T) {
+	tests := []struct {
+		name   string
+		note   string
+		octave int
+		want   float64
+	}{
+		{"A4 reference pitch", "A", 4, 440},
+		{"C4 middle C", "C", 4, 261.63},
+		{"A5 one octave up", "A", 5, 880},
+		{"flat spelling matches sharp", "Db", 4, 0}, // filled in below
+	}
+
+	dbFreq, err := NoteToFreq("C#", 4)
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	tests[3].want = dbFreq
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			got, err := NoteToFreq(tc.note, tc.octave)
+			if err != nil {
+				t.Fatalf("unexpected error: %v", err)
+			}
+			assertClose(t, got, tc.want, 0.01)
+		})
+	}
+}
+
+func TestNoteToFreqUnknownNote(t *testing.T) {
+	var target *UnknownNoteError
+	if _, err := NoteToFreq("H", 4); !errors.As(err, &target) {
+		t.Fatalf("expected *UnknownNoteError, got %v", err)
+	}
+}
+
+func TestFreqToNote(t *testing.T) {
+	note, octave, cents, err := FreqToNote(440)
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if note != "A" || octave != 4 {
+		t.Fatalf("FreqToNote(440) = (%q, %d), want (\"A\", 4)", note, octave)
+	}
+	if math.Abs(cents) > 0.01 {
+		t.Fatalf("cents = %v, want ~0", cents)
+	}
+}
+
+func TestFreqToNoteMiddleC(t *testing.T) {
+	note, octave, _, err := FreqToNote(261.63)
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if note != "C" || octave != 4 {
+		t.Fatalf("FreqToNote(261.63) = (%q, %d), want (\"C\", 4)", note, octave)
+	}
+}
+
+func TestFreqToNoteNonPositive(t *testing.T) {
+	var target *NonPositiveFrequencyError
+	if _, _, _, err := FreqToNote(0); !errors.As(err, &target) {
+		t.Fatalf("expected *NonPositiveFrequencyError, got %v", err)
+	}
+	if _, _, _, err := FreqToNote(-10); !errors.As(err, &target) {
+		t.Fatalf("expected *NonPositiveFrequencyError, got %v", err)
+	}
+}
+
+func TestRoundTrip(t *testing.T) {
+	freq, err := NoteToFreq("G", 3)
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	note, octave, cents, err := FreqToNote(freq)
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if note != "G" || octave != 3 {
+		t.Fatalf("round trip = (%q, %d), want (\"G\", 3)", note, octave)
+	}
+	if math.Abs(cents) > 0.01 {
+		t.Fatalf("cents = %v, want ~0", cents)
+	}
+}
@@ -0,0 +1,68 @@
+// Package numbertheory provides prime factorization, GCD, and LCM for
+// int64 values.
+package numbertheory
+
+import "fmt"
+
+// TooSmallError is returned by Factorize when n is less than 2, since
+// prime factorization isn't defined for 0, 1, or negative numbers.
+type TooSmallError struct {
+	N int64
+}
+
+func (e *TooSmallError) Error() string {
+	return fmt.Sprintf("numbertheory: %d is too small to factorize, n must be at least 2", e.N)
+}
+
+// Factorize returns n's prime factors in ascending order, with
+// multiplicity, e.g. Factorize(360) returns [2, 2, 2, 3, 3, 5]. n must
+// be at least 2.
+func Factorize(n int64) ([]int64, error) {
+	if n < 2 {
+		return nil, &TooSmallError{N: n}
+	}
+
+	var factors []int64
+	for p := int64(2); p*p <= n; p++ {
+		for n%p == 0 {
+			factors = append(factors, p)
+			n /= p
+		}
+	}
+	if n > 1 {
+		factors = append(factors, n)
+	}
+	return factors, nil
+}
+
+// GCD returns the greatest common divisor of a and b via the Euclidean
+// algorithm. GCD(0, 0) is 0.
+func GCD(a, b int64) int64 {
+	if a < 0 {
+		a = -a
+	}
+	if b < 0 {
+		b = -b
+	}
+	for b != 0 {
+		a, b = b, a%b
+	}
+	return a
+}
+
+// LCM returns the least common multiple of a and b, always
+// non-negative. It divides by GCD before multiplying by b, rather than
+// multiplying first, so the intermediate result doesn't overflow int64
+// any sooner than the final answer would.
+func LCM(a, b int64) int64 {
+	if a == 0 || b == 0 {
+		return 0
+	}
+	if a < 0 {
+		a = -a
+	}
+	if b < 0 {
+		b = -b
+	}
+	return a / GCD(a, b) * b
+}
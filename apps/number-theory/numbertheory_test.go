@@ -0,0 +1,72 @@
+package numbertheory
+
+import (
+	"errors"
+	"reflect"
+	"testing"
+)
+
+func TestFactorize(t *testing.T) {
+	got, err := Factorize(360)
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	want := []int64{2, 2, 2, 3, 3, 5}
+	if !reflect.DeepEqual(got, want) {
+		t.Fatalf("Factorize(360) = %v, want %v", got, want)
+	}
+}
+
+func TestFactorizePrime(t *testing.T) {
+	got, err := Factorize(17)
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	want := []int64{17}
+	if !reflect.DeepEqual(got, want) {
+		t.Fatalf("Factorize(17) = %v, want %v", got, want)
+	}
+}
+
+func TestFactorizeTooSmall(t *testing.T) {
+	var target *TooSmallError
+	if _, err := Factorize(1); !errors.As(err, &target) {
+		t.Fatalf("expected *TooSmallError, got %v", err)
+	}
+	if _, err := Factorize(0); !errors.As(err, &target) {
+		t.Fatalf("expected *TooSmallError, got %v", err)
+	}
+	if _, err := Factorize(-5); !errors.As(err, &target) {
+		t.Fatalf("expected *TooSmallError, got %v", err)
+	}
+}
+
+func TestGCD(t *testing.T) {
+	if got := GCD(48, 36); got != 12 {
+		t.Fatalf("GCD(48, 36) = %d, want 12", got)
+	}
+	if got := GCD(0, 5); got != 5 {
+		t.Fatalf("GCD(0, 5) = %d, want 5", got)
+	}
+	if got := GCD(-48, 36); got != 12 {
+		t.Fatalf("GCD(-48, 36) = %d, want 12", got)
+	}
+}
+
+func TestLCM(t *testing.T) {
+	if got := LCM(4, 6); got != 12 {
+		t.Fatalf("LCM(4, 6) = %d, want 12", got)
+	}
+	if got := LCM(0, 5); got != 0 {
+		t.Fatalf("LCM(0, 5) = %d, want 0", got)
+	}
+}
+
+func TestLCMLargeNoOverflow(t *testing.T) {
+	const a, b int64 = 1_000_000_007, 999_999_937
+	got := LCM(a, b)
+	want := a * b // both prime, so LCM is their product
+	if got != want {
+		t.Fatalf("LCM(%d, %d) = %d, want %d", a, b, got, want)
+	}
+}
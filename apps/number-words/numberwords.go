@@ -0,0 +1,188 @@
+// Package numberwords converts between integers and their English word
+// representation, e.g. 1234 and "one thousand two hundred thirty-four".
+// Supported magnitudes run up to (but not including) one trillion.
+package numberwords
+
+import (
+	"fmt"
+	"math"
+	"strings"
+)
+
+// OutOfRangeError is returned by ToWords when n is too large in
+// magnitude for the supported thousand/million/billion scale words.
+type OutOfRangeError struct {
+	Value int64
+}
+
+func (e *OutOfRangeError) Error() string {
+	return fmt.Sprintf("numberwords: %d is outside the supported range (magnitude must be below one trillion)", e.Value)
+}
+
+// InvalidWordError is returned by FromWords when s contains a word (or
+// word combination) that doesn't form a valid number.
+type InvalidWordError struct {
+	Word string
+}
+
+func (e *InvalidWordError) Error() string {
+	return fmt.Sprintf("numberwords: %q is not a recognized number word", e.Word)
+}
+
+// onesWords holds the words for 0-19, the range that doesn't decompose
+// into a tens word plus a ones word.
+var onesWords = []string{
+	"zero", "one", "two", "three", "four", "five", "six", "seven", "eight", "nine",
+	"ten", "eleven", "twelve", "thirteen", "fourteen", "fifteen", "sixteen",
+	"seventeen", "eighteen", "nineteen",
+}
+
+// tensWords holds the words for the multiples of ten from 20-90;
+// indices 0 and 1 are unused since those values are covered by
+// onesWords.
+var tensWords = []string{"", "", "twenty", "thirty", "forty", "fifty", "sixty", "seventy", "eighty", "ninety"}
+
+// scaleWords names the magnitude of each group of three digits, from
+// least to most significant; index 0 (the units group) has no name.
+var scaleWords = []string{"", "thousand", "million", "billion"}
+
+// wordValues and scaleValues invert onesWords/tensWords and
+// scaleWords/hundred for FromWords, built once at init rather than
+// duplicating the numbers in a second literal.
+var (
+	wordValues  = make(map[string]int64)
+	scaleValues = make(map[string]int64)
+)
+
+func init() {
+	for i, w := range onesWords {
+		wordValues[w] = int64(i)
+	}
+	for i, w := range tensWords {
+		if w != "" {
+			wordValues[w] = int64(i * 10)
+		}
+	}
+	scaleValues["hundred"] = 100
+	for i, w := range scaleWords {
+		if w != "" {
+			scaleValues[w] = int64(math.Pow10(3 * i))
+		}
+	}
+}
+
+// threeDigitsToWords renders n, which must be in 0-999, as words.
+func threeDigitsToWords(n int) string {
+	var parts []string
+	if n >= 100 {
+		parts = append(parts, onesWords[n/100], "hundred")
+		n %= 100
+	}
+	switch {
+	case n >= 20:
+		tensPart := tensWords[n/10]
+		if n%10 != 0 {
+			tensPart += "-" + onesWords[n%10]
+		}
+		parts = append(parts, tensPart)
+	case n > 0:
+		parts = append(parts, onesWords[n])
+	}
+	return strings.Join(parts, " ")
+}
+
+// ToWords renders n as English words, e.g. ToWords(1234) returns "one
+// thousand two hundred thirty-four". A negative n is prefixed with
+// "negative". n's magnitude must be below one trillion; anything
+// larger returns an *OutOfRangeError.
+func ToWords(n int64) (string, error) {
+	if n == 0 {
+		return "zero", nil
+	}
+	if n == math.MinInt64 {
+		return "", &OutOfRangeError{Value: n}
+	}
+
+	negative := n < 0
+	abs := n
+	if negative {
+		abs = -abs
+	}
+	if abs >= 1_000_000_000_000 {
+		return "", &OutOfRangeError{Value: n}
+	}
+
+	var groups []int
+	for abs > 0 {
+		groups = append(groups, int(abs%1000))
+		abs /= 1000
+	}
+
+	var parts []string
+	for i := len(groups) - 1; i >= 0; i-- {
+		g := groups[i]
+		if g == 0 {
+			continue
+		}
+		words := threeDigitsToWords(g)
+		if scaleWords[i] != "" {
+			words += " " + scaleWords[i]
+		}
+		parts = append(parts, words)
+	}
+
+	result := strings.Join(parts, " ")
+	if negative {
+		result = "negative " + result
+	}
+	return result, nil
+}
+
+// FromWords parses s, an English number phrase like ToWords produces,
+// back into an int64. Hyphens (as in "thirty-four") and repeated or
+// surrounding whitespace are tolerated. A word that isn't a recognized
+// number word, or a malformed combination (e.g. "hundred" with nothing
+// before it), returns an *InvalidWordError.
+func FromWords(s string) (int64, error) {
+	tokens := strings.Fields(strings.ToLower(strings.ReplaceAll(s, "-", " ")))
+	if len(tokens) == 0 {
+		return 0, &InvalidWordError{Word: s}
+	}
+
+	negative := false
+	i := 0
+	if tokens[0] == "negative" {
+		negative = true
+		i++
+	}
+	if i >= len(tokens) {
+		return 0, &InvalidWordError{Word: s}
+	}
+
+	var total, current int64
+	for ; i < len(tokens); i++ {
+		tok := tokens[i]
+		switch {
+		case tok == "hundred":
+			if current == 0 {
+				return 0, &InvalidWordError{Word: tok}
+			}
+			current *= 100
+		case scaleValues[tok] != 0:
+			total += current * scaleValues[tok]
+			current = 0
+		default:
+			v, ok := wordValues[tok]
+			if !ok {
+				return 0, &InvalidWordError{Word: tok}
+			}
+			current += v
+		}
+	}
+	total += current
+
+	if negative {
+		total = -total
+	}
+	return total, nil
+}
@@ -0,0 +1,85 @@
+package numberwords
+
+import (
+	"errors"
+	"testing"
+)
+
+func TestToWords(t *testing.T) {
+	tests := []struct {
+		n    int64
+		want string
+	}{
+		{0, "zero"},
+		{7, "seven"},
+		{42, "forty-two"},
+		{100, "one hundred"},
+		{1234, "one thousand two hundred thirty-four"},
+		{1_000_000, "one million"},
+		{2_500_000_000, "two billion five hundred million"},
+		{-5, "negative five"},
+	}
+	for _, tc := range tests {
+		got, err := ToWords(tc.n)
+		if err != nil {
+			t.Fatalf("ToWords(%d) returned error: %v", tc.n, err)
+		}
+		if got != tc.want {
+			t.Errorf("ToWords(%d) = %q, want %q", tc.n, got, tc.want)
+		}
+	}
+}
+
+func TestToWordsOutOfRange(t *testing.T) {
+	var target *OutOfRangeError
+	if _, err := ToWords(1_000_000_000_000); !errors.As(err, &target) {
+		t.Fatalf("expected *OutOfRangeError, got %v", err)
+	}
+}
+
+func TestFromWords(t *testing.T) {
+	tests := []struct {
+		s    string
+		want int64
+	}{
+		{"zero", 0},
+		{"forty-two", 42},
+		{"one hundred", 100},
+		{"one thousand two hundred thirty-four", 1234},
+		{"  one   thousand   two hundred thirty four  ", 1234},
+		{"two billion five hundred million", 2_500_000_000},
+		{"negative five", -5},
+	}
+	for _, tc := range tests {
+		got, err := FromWords(tc.s)
+		if err != nil {
+			t.Fatalf("FromWords(%q) returned error: %v", tc.s, err)
+		}
+		if got != tc.want {
+			t.Errorf("FromWords(%q) = %d, want %d", tc.s, got, tc.want)
+		}
+	}
+}
+
+func TestFromWordsInvalidWord(t *testing.T) {
+	var target *InvalidWordError
+	if _, err := FromWords("one gazillion"); !errors.As(err, &target) {
+		t.Fatalf("expected *InvalidWordError, got %v", err)
+	}
+}
+
+func TestRoundTrip(t *testing.T) {
+	for _, n := range []int64{0, 1, 19, 42, 100, 1234, 999_999_999_999 - 1} {
+		words, err := ToWords(n)
+		if err != nil {
+			t.Fatalf("ToWords(%d) returned error: %v", n, err)
+		}
+		got, err := FromWords(words)
+		if err != nil {
+			t.Fatalf("FromWords(%q) returned error: %v", words, err)
+		}
+		if got != n {
+			t.Errorf("round trip for %d = %d via %q", n, got, words)
+		}
+	}
+}
@@ -0,0 +1,114 @@
+// Package passwordstrength scores passwords on a 0-4 scale using
+// length, character-class diversity, and penalties for common weak
+// patterns, with no external dependencies beyond the standard
+// library's unicode classification.
+package passwordstrength
+
+import "unicode"
+
+// Score rates password from 0 (very weak) to 4 (strong) and returns
+// actionable feedback messages for whatever is holding the score back.
+// An empty password always scores 0.
+func Score(password string) (score int, feedback []string) {
+	if password == "" {
+		return 0, []string{"password is empty"}
+	}
+
+	if len(password) < 8 {
+		feedback = append(feedback, "too short")
+	}
+
+	hasLower, hasUpper, hasDigit, hasSymbol := classify(password)
+	classes := 0
+	if hasLower {
+		classes++
+	} else {
+		feedback = append(feedback, "add a lowercase letter")
+	}
+	if hasUpper {
+		classes++
+	} else {
+		feedback = append(feedback, "add an uppercase letter")
+	}
+	if hasDigit {
+		classes++
+	} else {
+		feedback = append(feedback, "add a digit")
+	}
+	if hasSymbol {
+		classes++
+	} else {
+		feedback = append(feedback, "add a symbol")
+	}
+
+	if len(password) >= 8 {
+		score++
+	}
+	if len(password) >= 12 {
+		score++
+	}
+	if classes >= 3 {
+		score++
+	}
+	if classes == 4 {
+		score++
+	}
+
+	if allDigits(password) {
+		score = 0
+		feedback = append(feedback, "avoid using only numbers")
+	}
+	if hasRepeatedRun(password, 3) {
+		if score > 0 {
+			score--
+		}
+		feedback = append(feedback, "avoid repeated characters")
+	}
+
+	return score, feedback
+}
+
+// classify reports which character classes appear anywhere in s.
+func classify(s string) (hasLower, hasUpper, hasDigit, hasSymbol bool) {
+	for _, r := range s {
+		switch {
+		case unicode.IsLower(r):
+			hasLower = true
+		case unicode.IsUpper(r):
+			hasUpper = true
+		case unicode.IsDigit(r):
+			hasDigit = true
+		case unicode.IsPunct(r) || unicode.IsSymbol(r):
+			hasSymbol = true
+		}
+	}
+	return hasLower, hasUpper, hasDigit, hasSymbol
+}
+
+// allDigits reports whether every character in s is a digit.
+func allDigits(s string) bool {
+	for _, r := range s {
+		if !unicode.IsDigit(r) {
+			return false
+		}
+	}
+	return true
+}
+
+// hasRepeatedRun reports whether s contains the same character repeated
+// n or more times in a row, e.g. hasRepeatedRun("aaab", 3) is true.
+func hasRepeatedRun(s string, n int) bool {
+	runes := []rune(s)
+	run := 1
+	for i := 1; i < len(runes); i++ {
+		if runes[i] == runes[i-1] {
+			run++
+			if run >= n {
+				return true
+			}
+		} else {
+			run = 1
+		}
+	}
+	return false
+}
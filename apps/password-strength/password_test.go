@@ -0,0 +1,67 @@
+package passwordstrength
+
+import "testing"
+
+func TestScoreEmpty(t *testing.T) {
+	score, feedback := Score("")
+	if score != 0 {
+		t.Fatalf("score = %d, want 0", score)
+	}
+	if len(feedback) == 0 {
+		t.Fatal("expected feedback for empty password")
+	}
+}
+
+func TestScoreStrongMixed(t *testing.T) {
+	score, feedback := Score("Tr0ub4dor&3Zz")
+	if score != 4 {
+		t.Fatalf("score = %d, want 4, feedback: %v", score, feedback)
+	}
+}
+
+func TestScorePurelyNumeric(t *testing.T) {
+	score, feedback := Score("12345678")
+	if score != 0 {
+		t.Fatalf("score = %d, want 0 for purely numeric password", score)
+	}
+	found := false
+	for _, f := range feedback {
+		if f == "avoid using only numbers" {
+			found = true
+		}
+	}
+	if !found {
+		t.Fatalf("expected numeric-only feedback, got %v", feedback)
+	}
+}
+
+func TestScoreTooShort(t *testing.T) {
+	_, feedback := Score("Ab1!")
+	found := false
+	for _, f := range feedback {
+		if f == "too short" {
+			found = true
+		}
+	}
+	if !found {
+		t.Fatalf("expected too-short feedback, got %v", feedback)
+	}
+}
+
+func TestScoreRepeatedCharacters(t *testing.T) {
+	scoreRepeated, feedbackRepeated := Score("Aaaa1234!")
+	found := false
+	for _, f := range feedbackRepeated {
+		if f == "avoid repeated characters" {
+			found = true
+		}
+	}
+	if !found {
+		t.Fatalf("expected repeated-character feedback, got %v", feedbackRepeated)
+	}
+
+	scoreNoRepeat, _ := Score("Abcd1234!")
+	if scoreRepeated >= scoreNoRepeat {
+		t.Fatalf("expected repeated-character penalty to lower the score below the no-repeat equivalent: repeated=%d, norepeat=%d", scoreRepeated, scoreNoRepeat)
+	}
+}
@@ -0,0 +1,38 @@
+// Package percentage provides percentage and ratio helpers for finance
+// and statistics work: percent change between two values, what
+// fraction one value is of another, and applying a percentage to a
+// base value.
+package percentage
+
+import "errors"
+
+// ErrZeroDenominator is returned by ChangePercent and PercentOf when
+// the denominator they would divide by is zero.
+var ErrZeroDenominator = errors.New("percentage: denominator must not be zero")
+
+// ChangePercent returns the percent change from old to updated, e.g.
+// ChangePercent(50, 75) returns 50 (a 50% increase). old must not be
+// zero, or ChangePercent returns ErrZeroDenominator.
+func ChangePercent(old, updated float64) (float64, error) {
+	if old == 0 {
+		return 0, ErrZeroDenominator
+	}
+	return (updated - old) / old * 100, nil
+}
+
+// PercentOf returns what percentage part is of whole, e.g.
+// PercentOf(25, 200) returns 12.5. whole must not be zero, or
+// PercentOf returns ErrZeroDenominator.
+func PercentOf(part, whole float64) (float64, error) {
+	if whole == 0 {
+		return 0, ErrZeroDenominator
+	}
+	return part / whole * 100, nil
+}
+
+// ApplyPercent returns base adjusted by pct percent, e.g.
+// ApplyPercent(200, 10) returns 220 and ApplyPercent(200, -10) returns
+// 180.
+func ApplyPercent(base, pct float64) float64 {
+	return base * (1 + pct/100)
+}
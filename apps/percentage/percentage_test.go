@@ -0,0 +1,64 @@
+package percentage
+
+import (
+	"errors"
+	"math"
+	"testing"
+)
+
+func assertClose(t *testing.T, got, want, tol float64) {
+	t.Helper()
+	if math.Abs(got-want) > tol {
+		t.Fatalf("got %v, want %v", got, want)
+	}
+}
+
+func TestChangePercent(t *testing.T) {
+	got, err := ChangePercent(50, 75)
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	assertClose(t, got, 50, 1e-9)
+}
+
+func TestChangePercentZeroDenominator(t *testing.T) {
+	_, err := ChangePercent(0, 10)
+	if !errors.Is(err, ErrZeroDenominator) {
+		t.Fatalf("expected ErrZeroDenominator, got %v", err)
+	}
+}
+
+func TestPercentOf(t *testing.T) {
+	got, err := PercentOf(25, 200)
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	assertClose(t, got, 12.5, 1e-9)
+}
+
+func TestPercentOfZeroDenominator(t *testing.T) {
+	_, err := PercentOf(25, 0)
+	if !errors.Is(err, ErrZeroDenominator) {
+		t.Fatalf("expected ErrZeroDenominator, got %v", err)
+	}
+}
+
+func TestApplyPercent(t *testing.T) {
+	tests := []struct {
+		name string
+		base float64
+		pct  float64
+		want float64
+	}{
+		{"10 percent increase", 200, 10, 220},
+		{"10 percent decrease", 200, -10, 180},
+		{"zero percent", 100, 0, 100},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			got := ApplyPercent(tc.base, tc.pct)
+			assertClose(t, got, tc.want, 1e-9)
+		})
+	}
+}
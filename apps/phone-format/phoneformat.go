@@ -0,0 +1,162 @@
+// Package phoneformat normalizes and formats phone numbers for a
+// small set of common country dialing rules, without depending on a
+// full phone-number library.
+package phoneformat
+
+import (
+	"fmt"
+	"strings"
+)
+
+// UnsupportedRegionError is returned when a region isn't one of the
+// regions regionRules covers.
+type UnsupportedRegionError struct {
+	Region string
+}
+
+func (e *UnsupportedRegionError) Error() string {
+	return fmt.Sprintf("phoneformat: unsupported region %q", e.Region)
+}
+
+// InvalidLengthError is returned when a number's digit count doesn't
+// match its region's expected national-number length.
+type InvalidLengthError struct {
+	Number string
+	Region string
+}
+
+func (e *InvalidLengthError) Error() string {
+	return fmt.Sprintf("phoneformat: %q is not a valid length for region %q", e.Number, e.Region)
+}
+
+// InvalidE164Error is returned when a string isn't a valid E.164
+// number (a "+" followed by 8-15 digits).
+type InvalidE164Error struct {
+	Number string
+}
+
+func (e *InvalidE164Error) Error() string {
+	return fmt.Sprintf("phoneformat: %q is not a valid E.164 number", e.Number)
+}
+
+// UnknownStyleError is returned when Format is given a style other
+// than "national" or "international".
+type UnknownStyleError struct {
+	Style string
+}
+
+func (e *UnknownStyleError) Error() string {
+	return fmt.Sprintf("phoneformat: unknown format style %q, want \"national\" or \"international\"", e.Style)
+}
+
+// regionRule describes one supported region's dialing convention: its
+// country calling code and the number of digits its national number
+// (the part after the calling code) must have.
+type regionRule struct {
+	CallingCode    string
+	NationalDigits int
+}
+
+// regionRules covers a handful of common regions. It is not meant to
+// be exhaustive; Normalize and Format reject any region not listed
+// here.
+var regionRules = map[string]regionRule{
+	"US": {CallingCode: "1", NationalDigits: 10},
+	"GB": {CallingCode: "44", NationalDigits: 10},
+	"DE": {CallingCode: "49", NationalDigits: 11},
+	"FR": {CallingCode: "33", NationalDigits: 9},
+	"JP": {CallingCode: "81", NationalDigits: 10},
+}
+
+// digitsOnly strips everything but ASCII digits and a leading "+" from
+// s.
+func digitsOnly(s string) string {
+	var b strings.Builder
+	for i, r := range s {
+		if r == '+' && i == 0 {
+			b.WriteRune(r)
+			continue
+		}
+		if r >= '0' && r <= '9' {
+			b.WriteRune(r)
+		}
+	}
+	return b.String()
+}
+
+// Normalize strips formatting (spaces, dashes, parentheses) from
+// number and returns it as an E.164 string ("+" followed by the
+// country calling code and national number). If number already starts
+// with "+", it's assumed to already include its calling code and is
+// only validated, not re-prefixed. Otherwise it's treated as a
+// national number for defaultRegion, which must be one of regionRules.
+// The digit count after the calling code must match defaultRegion's
+// expected length, or an *InvalidLengthError is returned.
+func Normalize(number, defaultRegion string) (string, error) {
+	cleaned := digitsOnly(number)
+
+	if strings.HasPrefix(cleaned, "+") {
+		if err := validateE164(cleaned); err != nil {
+			return "", err
+		}
+		return cleaned, nil
+	}
+
+	rule, ok := regionRules[defaultRegion]
+	if !ok {
+		return "", &UnsupportedRegionError{Region: defaultRegion}
+	}
+
+	national := cleaned
+	if strings.HasPrefix(national, rule.CallingCode) && len(national) > rule.NationalDigits {
+		national = strings.TrimPrefix(national, rule.CallingCode)
+	}
+	if len(national) != rule.NationalDigits {
+		return "", &InvalidLengthError{Number: number, Region: defaultRegion}
+	}
+	return "+" + rule.CallingCode + national, nil
+}
+
+// validateE164 reports whether s is a "+" followed by 8-15 digits, the
+// shape of a valid E.164 number.
+func validateE164(s string) error {
+	if !strings.HasPrefix(s, "+") {
+		return &InvalidE164Error{Number: s}
+	}
+	digits := s[1:]
+	if len(digits) < 8 || len(digits) > 15 {
+		return &InvalidE164Error{Number: s}
+	}
+	for _, r := range digits {
+		if r < '0' || r > '9' {
+			return &InvalidE164Error{Number: s}
+		}
+	}
+	return nil
+}
+
+// Format renders e164, an E.164 number as Normalize produces, in
+// style "national" (just the national number, with no calling code)
+// or "international" ("+" followed by the calling code, a space, and
+// the national number). e164's calling code must match one of
+// regionRules.
+func Format(e164, style string) (string, error) {
+	if err := validateE164(e164); err != nil {
+		return "", err
+	}
+	if style != "national" && style != "international" {
+		return "", &UnknownStyleError{Style: style}
+	}
+
+	digits := e164[1:]
+	for _, rule := range regionRules {
+		if strings.HasPrefix(digits, rule.CallingCode) && len(digits)-len(rule.CallingCode) == rule.NationalDigits {
+			national := digits[len(rule.CallingCode):]
+			if style == "national" {
+				return national, nil
+			}
+			return "+" + rule.CallingCode + " " + national, nil
+		}
+	}
+	return "", &InvalidE164Error{Number: e164}
+}
@@ -0,0 +1,76 @@
+package phoneformat
+
+import (
+	"errors"
+	"testing"
+)
+
+func TestNormalizeUSNumber(t *testing.T) {
+	got, err := Normalize("(212) 555-0123", "US")
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	want := "+12125550123"
+	if got != want {
+		t.Fatalf("Normalize((212) 555-0123, US) = %q, want %q", got, want)
+	}
+}
+
+func TestNormalizeAlreadyE164(t *testing.T) {
+	got, err := Normalize("+12125550123", "US")
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	want := "+12125550123"
+	if got != want {
+		t.Fatalf("Normalize(+12125550123, US) = %q, want %q", got, want)
+	}
+}
+
+func TestNormalizeInvalidLength(t *testing.T) {
+	var target *InvalidLengthError
+	if _, err := Normalize("555-0123", "US"); !errors.As(err, &target) {
+		t.Fatalf("expected *InvalidLengthError, got %v", err)
+	}
+}
+
+func TestNormalizeUnsupportedRegion(t *testing.T) {
+	var target *UnsupportedRegionError
+	if _, err := Normalize("212 555 0123", "ZZ"); !errors.As(err, &target) {
+		t.Fatalf("expected *UnsupportedRegionError, got %v", err)
+	}
+}
+
+func TestFormatNational(t *testing.T) {
+	got, err := Format("+12125550123", "national")
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if got != "2125550123" {
+		t.Fatalf("Format(national) = %q, want %q", got, "2125550123")
+	}
+}
+
+func TestFormatInternational(t *testing.T) {
+	got, err := Format("+12125550123", "international")
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if got != "+1 2125550123" {
+		t.Fatalf("Format(international) = %q, want %q", got, "+1 2125550123")
+	}
+}
+
+func TestFormatUnknownStyle(t *testing.T) {
+	var target *UnknownStyleError
+	if _, err := Format("+12125550123", "bogus"); !errors.As(err, &target) {
+		t.Fatalf("expected *UnknownStyleError, got %v", err)
+	}
+}
+
+func TestFormatInvalidE164(t *testing.T) {
+	var target *InvalidE164Error
+	if _, err := Format("2125550123", "national"); !errors.As(err, &target) {
+		t.Fatalf("expected *InvalidE164Error, got %v", err)
+	}
+}
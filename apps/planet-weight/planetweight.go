@@ -0,0 +1,71 @@
+// Package planetweight estimates how much a given Earth weight would
+// be on another body in the solar system, by scaling it by that body's
+// surface gravity relative to Earth's.
+package planetweight
+
+import (
+	"fmt"
+	"sort"
+)
+
+// UnknownPlanetError is returned when planet isn't one of the names
+// ListPlanets returns.
+type UnknownPlanetError struct {
+	Planet string
+}
+
+func (e *UnknownPlanetError) Error() string {
+	return fmt.Sprintf("planetweight: unknown planet %q, want one of %v", e.Planet, ListPlanets())
+}
+
+// NegativeWeightError is returned when earthWeightKg is negative, since
+// a negative weight isn't physically meaningful.
+type NegativeWeightError struct {
+	WeightKg float64
+}
+
+func (e *NegativeWeightError) Error() string {
+	return fmt.Sprintf("planetweight: weight must not be negative, got %g", e.WeightKg)
+}
+
+// gravityRatios maps each supported body to its surface gravity
+// relative to Earth's (Earth is 1 by definition), e.g. the Moon's is
+// 0.165 because its surface gravity is about 16.5% of Earth's.
+var gravityRatios = map[string]float64{
+	"Mercury": 0.378,
+	"Venus":   0.907,
+	"Earth":   1,
+	"Moon":    0.165,
+	"Mars":    0.377,
+	"Jupiter": 2.528,
+	"Saturn":  1.065,
+	"Uranus":  0.886,
+	"Neptune": 1.137,
+	"Pluto":   0.063,
+}
+
+// WeightOn returns the weight, in kilograms, that earthWeightKg would
+// register on planet, by scaling it by planet's surface gravity
+// relative to Earth's, e.g. WeightOn("Mars", 100) returns 37.7. planet
+// must be one of ListPlanets, matched case-sensitively, and
+// earthWeightKg must not be negative.
+func WeightOn(planet string, earthWeightKg float64) (float64, error) {
+	ratio, ok := gravityRatios[planet]
+	if !ok {
+		return 0, &UnknownPlanetError{Planet: planet}
+	}
+	if earthWeightKg < 0 {
+		return 0, &NegativeWeightError{WeightKg: earthWeightKg}
+	}
+	return earthWeightKg * ratio, nil
+}
+
+// ListPlanets returns the names WeightOn accepts, sorted alphabetically.
+func ListPlanets() []string {
+	names := make([]string, 0, len(gravityRatios))
+	for name := range gravityRatios {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+	return names
+}
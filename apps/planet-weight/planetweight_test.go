@@ -0,0 +1,56 @@
+package planetweight
+
+import (
+	"errors"
+	"testing"
+)
+
+func TestWeightOnEarth(t *testing.T) {
+	got, err := WeightOn("Earth", 70)
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if got != 70 {
+		t.Fatalf("WeightOn(Earth, 70) = %g, want 70", got)
+	}
+}
+
+func TestWeightOnMars(t *testing.T) {
+	got, err := WeightOn("Mars", 100)
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if got != 37.7 {
+		t.Fatalf("WeightOn(Mars, 100) = %g, want 37.7", got)
+	}
+}
+
+func TestWeightOnUnknownPlanet(t *testing.T) {
+	var target *UnknownPlanetError
+	if _, err := WeightOn("Vulcan", 70); !errors.As(err, &target) {
+		t.Fatalf("expected *UnknownPlanetError, got %v", err)
+	}
+}
+
+func TestWeightOnNegativeWeight(t *testing.T) {
+	var target *NegativeWeightError
+	if _, err := WeightOn("Earth", -1); !errors.As(err, &target) {
+		t.Fatalf("expected *NegativeWeightError, got %v", err)
+	}
+}
+
+func TestListPlanets(t *testing.T) {
+	planets := ListPlanets()
+	if len(planets) == 0 {
+		t.Fatal("expected a non-empty list of planets")
+	}
+	found := false
+	for _, p := range planets {
+		if p == "Earth" {
+			found = true
+		}
+	}
+	if !found {
+		t.Fatalf("ListPlanets() = %v, want it to include Earth", planets)
+	}
+}
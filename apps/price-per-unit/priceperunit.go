@@ -0,0 +1,73 @@
+// Package priceperunit compares the price-per-unit of two shopping
+// offers, normalizing across convertible units (e.g. grams vs
+// kilograms) via the unit-converter package so "500 g for $2" and
+// "1 kg for $3.50" can be compared directly.
+package priceperunit
+
+import (
+	"fmt"
+
+	converter "github.com/richoandika/community-toolbox/apps/unit-converter"
+)
+
+// NonPositiveQuantityError is returned when an offer's quantity is zero
+// or negative, for which a price-per-unit is meaningless.
+type NonPositiveQuantityError struct {
+	Quantity float64
+}
+
+func (e *NonPositiveQuantityError) Error() string {
+	return fmt.Sprintf("priceperunit: quantity must be positive, got %g", e.Quantity)
+}
+
+// Offer describes a priced package: price for quantity units of unit,
+// e.g. {Price: 2, Quantity: 500, Unit: "g"}.
+type Offer struct {
+	Price    float64
+	Quantity float64
+	Unit     string
+}
+
+// PricePerUnit returns the price of a single unit, e.g.
+// PricePerUnit(2, 500, "g") returns 0.004 ($/g). unit is not used in the
+// calculation itself; it is carried so the result can be reported
+// alongside the unit it is expressed in. quantity must be positive.
+func PricePerUnit(price, quantity float64, unit string) (float64, error) {
+	if quantity <= 0 {
+		return 0, &NonPositiveQuantityError{Quantity: quantity}
+	}
+	_ = unit
+	return price / quantity, nil
+}
+
+// Cheaper returns whichever of a and b has the lower price-per-unit,
+// converting b's quantity into a's unit first if the two use different
+// but convertible units (e.g. grams vs kilograms). Both offers must have
+// a positive quantity, and if their units differ, the units must share
+// a dimension; otherwise Cheaper returns an error.
+func Cheaper(a, b Offer) (Offer, error) {
+	aPricePerUnit, err := PricePerUnit(a.Price, a.Quantity, a.Unit)
+	if err != nil {
+		return Offer{}, err
+	}
+	if b.Quantity <= 0 {
+		return Offer{}, &NonPositiveQuantityError{Quantity: b.Quantity}
+	}
+
+	bQuantityInAUnit := b.Quantity
+	if b.Unit != a.Unit {
+		bQuantityInAUnit, err = converter.ConvertUnits(b.Unit, a.Unit, b.Quantity)
+		if err != nil {
+			return Offer{}, err
+		}
+	}
+	bPricePerUnit, err := PricePerUnit(b.Price, bQuantityInAUnit, a.Unit)
+	if err != nil {
+		return Offer{}, err
+	}
+
+	if bPricePerUnit < aPricePerUnit {
+		return b, nil
+	}
+	return a, nil
+}
@@ -0,0 +1,81 @@
+package priceperunit
+
+import (
+	"errors"
+	"math"
+	"testing"
+)
+
+func assertClose(t *testing.T, got, want, tol float64) {
+	t.Helper()
+	if math.Abs(got-want) > tol {
+		t.Fatalf("got %v, want %v", got, want)
+	}
+}
+
+func TestPricePerUnit(t *testing.T) {
+	got, err := PricePerUnit(2, 500, "g")
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	assertClose(t, got, 0.004, 1e-9)
+}
+
+func TestPricePerUnitNonPositiveQuantity(t *testing.T) {
+	var target *NonPositiveQuantityError
+	_, err := PricePerUnit(2, 0, "g")
+	if !errors.As(err, &target) {
+		t.Fatalf("expected *NonPositiveQuantityError, got %v", err)
+	}
+
+	_, err = PricePerUnit(2, -1, "g")
+	if !errors.As(err, &target) {
+		t.Fatalf("expected *NonPositiveQuantityError, got %v", err)
+	}
+}
+
+func TestCheaperSameUnit(t *testing.T) {
+	a := Offer{Price: 3, Quantity: 12, Unit: "oz"}
+	b := Offer{Price: 5, Quantity: 16, Unit: "oz"}
+
+	got, err := Cheaper(a, b)
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if got != a {
+		t.Fatalf("Cheaper(a, b) = %+v, want %+v", got, a)
+	}
+}
+
+func TestCheaperCrossUnit(t *testing.T) {
+	// 500 g for $2 is $0.004/g; 1 kg for $3.50 is $0.0035/g.
+	a := Offer{Price: 2, Quantity: 500, Unit: "g"}
+	b := Offer{Price: 3.50, Quantity: 1, Unit: "kg"}
+
+	got, err := Cheaper(a, b)
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if got != b {
+		t.Fatalf("Cheaper(a, b) = %+v, want %+v", got, b)
+	}
+}
+
+func TestCheaperNonPositiveQuantity(t *testing.T) {
+	a := Offer{Price: 2, Quantity: 500, Unit: "g"}
+	b := Offer{Price: 3.50, Quantity: 0, Unit: "kg"}
+
+	var target *NonPositiveQuantityError
+	if _, err := Cheaper(a, b); !errors.As(err, &target) {
+		t.Fatalf("expected *NonPositiveQuantityError")
+	}
+}
+
+func TestCheaperIncompatibleUnits(t *testing.T) {
+	a := Offer{Price: 2, Quantity: 500, Unit: "g"}
+	b := Offer{Price: 3.50, Quantity: 1, Unit: "l"}
+
+	if _, err := Cheaper(a, b); err == nil {
+		t.Fatal("expected error for incompatible units")
+	}
+}
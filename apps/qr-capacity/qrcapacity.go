@@ -0,0 +1,136 @@
+// Package qrcapacity estimates how much data a QR code of a given
+// version, error-correction level, and encoding mode can hold, per the
+// capacities published in ISO/IEC 18004.
+package qrcapacity
+
+import "fmt"
+
+// InvalidVersionError is returned when version is outside the 1-40
+// range QR codes support.
+type InvalidVersionError struct {
+	Version int
+}
+
+func (e *InvalidVersionError) Error() string {
+	return fmt.Sprintf("qrcapacity: version must be between 1 and 40, got %d", e.Version)
+}
+
+// InvalidECLevelError is returned when ecLevel isn't one of L, M, Q, H.
+type InvalidECLevelError struct {
+	ECLevel string
+}
+
+func (e *InvalidECLevelError) Error() string {
+	return fmt.Sprintf("qrcapacity: unknown error-correction level %q, want one of L, M, Q, H", e.ECLevel)
+}
+
+// InvalidModeError is returned when mode isn't one of numeric,
+// alphanumeric, or byte.
+type InvalidModeError struct {
+	Mode string
+}
+
+func (e *InvalidModeError) Error() string {
+	return fmt.Sprintf("qrcapacity: unknown mode %q, want one of numeric, alphanumeric, byte", e.Mode)
+}
+
+// dataCodewords[version-1][level] is the total number of 8-bit data
+// codewords a symbol of that version and error-correction level
+// carries, per ISO/IEC 18004 Table 9. Every other capacity in this
+// package is derived from this table plus the mode's bit-packing rules,
+// rather than hardcoding a separate table per mode.
+var dataCodewords = [40][4]int{
+	{19, 16, 13, 9}, {34, 28, 22, 16}, {55, 44, 34, 26}, {80, 64, 48, 36},
+	{108, 86, 62, 46}, {136, 108, 76, 60}, {156, 124, 88, 66}, {194, 154, 110, 86},
+	{232, 182, 132, 100}, {274, 216, 154, 122}, {324, 254, 180, 140}, {370, 290, 206, 158},
+	{428, 334, 244, 180}, {461, 365, 261, 197}, {523, 415, 295, 223}, {589, 453, 325, 253},
+	{647, 507, 367, 283}, {721, 563, 397, 313}, {795, 627, 445, 341}, {861, 669, 485, 385},
+	{932, 714, 512, 406}, {1006, 782, 568, 442}, {1094, 860, 614, 464}, {1174, 914, 664, 514},
+	{1276, 1000, 718, 538}, {1370, 1062, 754, 596}, {1468, 1128, 808, 628}, {1531, 1193, 871, 661},
+	{1631, 1267, 911, 701}, {1735, 1373, 985, 745}, {1843, 1455, 1033, 793}, {1955, 1541, 1115, 845},
+	{2071, 1631, 1171, 901}, {2191, 1725, 1231, 961}, {2306, 1812, 1286, 986}, {2434, 1914, 1354, 1054},
+	{2566, 1992, 1426, 1096}, {2702, 2102, 1502, 1142}, {2812, 2216, 1582, 1222}, {2956, 2334, 1666, 1276},
+}
+
+// ecLevelIndex maps an error-correction level to its column in
+// dataCodewords.
+var ecLevelIndex = map[string]int{
+	"L": 0,
+	"M": 1,
+	"Q": 2,
+	"H": 3,
+}
+
+// modeIndicatorBits is the fixed-width mode indicator every encoded
+// segment starts with, regardless of version or mode.
+const modeIndicatorBits = 4
+
+// countIndicatorBits returns the number of bits used to encode the
+// character count for mode at version, which widens twice as version
+// grows (1-9, 10-26, 27-40) to keep pace with larger symbols.
+func countIndicatorBits(version int, mode string) int {
+	var bracket int
+	switch {
+	case version <= 9:
+		bracket = 0
+	case version <= 26:
+		bracket = 1
+	default:
+		bracket = 2
+	}
+
+	switch mode {
+	case "numeric":
+		return [3]int{10, 12, 14}[bracket]
+	case "alphanumeric":
+		return [3]int{9, 11, 13}[bracket]
+	default: // byte
+		return [3]int{8, 16, 16}[bracket]
+	}
+}
+
+// MaxChars returns the maximum number of characters a QR code of the
+// given version (1-40), error-correction level ("L", "M", "Q", or "H"),
+// and mode ("numeric", "alphanumeric", or "byte") can hold. It derives
+// the answer from the version and level's total data codewords (per
+// ISO/IEC 18004 Table 9) and the mode's bit-packing rules, rather than
+// a separate capacity table per mode.
+func MaxChars(version int, ecLevel string, mode string) (int, error) {
+	if version < 1 || version > 40 {
+		return 0, &InvalidVersionError{Version: version}
+	}
+	levelIdx, ok := ecLevelIndex[ecLevel]
+	if !ok {
+		return 0, &InvalidECLevelError{ECLevel: ecLevel}
+	}
+	if mode != "numeric" && mode != "alphanumeric" && mode != "byte" {
+		return 0, &InvalidModeError{Mode: mode}
+	}
+
+	totalBits := dataCodewords[version-1][levelIdx] * 8
+	availableBits := totalBits - modeIndicatorBits - countIndicatorBits(version, mode)
+
+	switch mode {
+	case "numeric":
+		// Every 3 digits pack into 10 bits; a last group of 2 digits
+		// takes 7 bits and a last group of 1 digit takes 4 bits.
+		chars := (availableBits / 10) * 3
+		switch rem := availableBits % 10; {
+		case rem >= 7:
+			chars += 2
+		case rem >= 4:
+			chars += 1
+		}
+		return chars, nil
+	case "alphanumeric":
+		// Every 2 characters pack into 11 bits; a last single
+		// character takes 6 bits.
+		chars := (availableBits / 11) * 2
+		if availableBits%11 >= 6 {
+			chars++
+		}
+		return chars, nil
+	default: // byte
+		return availableBits / 8, nil
+	}
+}
@@ -0,0 +1,68 @@
+package qrcapacity
+
+import (
+	"errors"
+	"testing"
+)
+
+func TestMaxCharsVersion1Examples(t *testing.T) {
+	cases := []struct {
+		ecLevel string
+		mode    string
+		want    int
+	}{
+		{"L", "numeric", 41},
+		{"L", "alphanumeric", 25},
+		{"L", "byte", 17},
+		{"M", "numeric", 34},
+		{"Q", "numeric", 27},
+		{"H", "numeric", 17},
+	}
+	for _, c := range cases {
+		got, err := MaxChars(1, c.ecLevel, c.mode)
+		if err != nil {
+			t.Fatalf("MaxChars(1, %q, %q) returned error: %v", c.ecLevel, c.mode, err)
+		}
+		if got != c.want {
+			t.Errorf("MaxChars(1, %q, %q) = %d, want %d", c.ecLevel, c.mode, got, c.want)
+		}
+	}
+}
+
+func TestMaxCharsGrowsWithVersion(t *testing.T) {
+	small, err := MaxChars(1, "L", "byte")
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	large, err := MaxChars(40, "L", "byte")
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if large <= small {
+		t.Fatalf("MaxChars(40, L, byte) = %d, want greater than MaxChars(1, L, byte) = %d", large, small)
+	}
+}
+
+func TestMaxCharsInvalidVersion(t *testing.T) {
+	var target *InvalidVersionError
+	if _, err := MaxChars(0, "L", "numeric"); !errors.As(err, &target) {
+		t.Fatalf("expected *InvalidVersionError, got %v", err)
+	}
+	if _, err := MaxChars(41, "L", "numeric"); !errors.As(err, &target) {
+		t.Fatalf("expected *InvalidVersionError, got %v", err)
+	}
+}
+
+func TestMaxCharsInvalidECLevel(t *testing.T) {
+	var target *InvalidECLevelError
+	if _, err := MaxChars(1, "X", "numeric"); !errors.As(err, &target) {
+		t.Fatalf("expected *InvalidECLevelError, got %v", err)
+	}
+}
+
+func TestMaxCharsInvalidMode(t *testing.T) {
+	var target *InvalidModeError
+	if _, err := MaxChars(1, "L", "kanji"); !errors.As(err, &target) {
+		t.Fatalf("expected *InvalidModeError, got %v", err)
+	}
+}
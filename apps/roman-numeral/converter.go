@@ -0,0 +1,101 @@
+// Package romannumeral converts between integers and Roman numerals,
+// restricted to the standard range 1-3999 and strict subtractive
+// notation (e.g. "IC" for 99 is rejected; the correct form is "XCIX").
+package romannumeral
+
+import "fmt"
+
+// OutOfRangeError is returned by ToRoman when n falls outside the
+// standard Roman numeral range of 1 to 3999.
+type OutOfRangeError struct {
+	Value int
+}
+
+func (e *OutOfRangeError) Error() string {
+	return fmt.Sprintf("romannumeral: %d is outside the supported range 1-3999", e.Value)
+}
+
+// InvalidNumeralError is returned by FromRoman when s is not a
+// well-formed Roman numeral.
+type InvalidNumeralError struct {
+	Value string
+}
+
+func (e *InvalidNumeralError) Error() string {
+	return fmt.Sprintf("romannumeral: %q is not a valid Roman numeral", e.Value)
+}
+
+// values lists the numeral symbols from largest to smallest, including
+// the subtractive pairs (CM, CD, XC, XL, IX, IV), so greedily
+// subtracting the largest symbol that fits always produces the
+// standard form.
+var values = []struct {
+	Symbol string
+	Value  int
+}{
+	{"M", 1000},
+	{"CM", 900},
+	{"D", 500},
+	{"CD", 400},
+	{"C", 100},
+	{"XC", 90},
+	{"L", 50},
+	{"XL", 40},
+	{"X", 10},
+	{"IX", 9},
+	{"V", 5},
+	{"IV", 4},
+	{"I", 1},
+}
+
+// ToRoman renders n as a Roman numeral. n must be in 1-3999; outside
+// that range ToRoman returns an *OutOfRangeError.
+func ToRoman(n int) (string, error) {
+	if n < 1 || n > 3999 {
+		return "", &OutOfRangeError{Value: n}
+	}
+
+	var sb []byte
+	for _, v := range values {
+		for n >= v.Value {
+			sb = append(sb, v.Symbol...)
+			n -= v.Value
+		}
+	}
+	return string(sb), nil
+}
+
+// FromRoman parses a Roman numeral back into an int, enforcing strict
+// subtractive notation: each symbol's value must not exceed the value
+// of the symbol before it unless it forms one of the six standard
+// subtractive pairs (CM, CD, XC, XL, IX, IV), and no symbol may repeat
+// more times than standard notation allows (e.g. "IIII" and "IC" are
+// both rejected). Malformed input returns an *InvalidNumeralError.
+func FromRoman(s string) (int, error) {
+	if s == "" {
+		return 0, &InvalidNumeralError{Value: s}
+	}
+
+	total := 0
+	rest := s
+	for _, v := range values {
+		for len(rest) >= len(v.Symbol) && rest[:len(v.Symbol)] == v.Symbol {
+			total += v.Value
+			rest = rest[len(v.Symbol):]
+		}
+	}
+	if rest != "" || total < 1 || total > 3999 {
+		return 0, &InvalidNumeralError{Value: s}
+	}
+
+	// Re-render and compare: the greedy consumption above accepts any
+	// concatenation of known symbols, including malformed ones like
+	// "IIII" or "VV" that never appear in a canonically-produced
+	// numeral, so round-tripping through ToRoman is the simplest way
+	// to enforce strict notation.
+	canonical, err := ToRoman(total)
+	if err != nil || canonical != s {
+		return 0, &InvalidNumeralError{Value: s}
+	}
+	return total, nil
+}
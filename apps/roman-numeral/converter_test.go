@@ -0,0 +1,97 @@
+package romannumeral
+
+import "testing"
+
+func TestToRoman(t *testing.T) {
+	tests := []struct {
+		n    int
+		want string
+	}{
+		{1, "I"},
+		{4, "IV"},
+		{9, "IX"},
+		{40, "XL"},
+		{90, "XC"},
+		{400, "CD"},
+		{900, "CM"},
+		{1994, "MCMXCIV"},
+		{3999, "MMMCMXCIX"},
+	}
+
+	for _, tc := range tests {
+		got, err := ToRoman(tc.n)
+		if err != nil {
+			t.Fatalf("ToRoman(%d): unexpected error: %v", tc.n, err)
+		}
+		if got != tc.want {
+			t.Fatalf("ToRoman(%d) = %q, want %q", tc.n, got, tc.want)
+		}
+	}
+}
+
+func TestToRomanOutOfRange(t *testing.T) {
+	for _, n := range []int{0, -1, 4000} {
+		_, err := ToRoman(n)
+		if err == nil {
+			t.Fatalf("ToRoman(%d): expected error", n)
+		}
+		if _, ok := err.(*OutOfRangeError); !ok {
+			t.Fatalf("ToRoman(%d): expected *OutOfRangeError, got %T", n, err)
+		}
+	}
+}
+
+func TestFromRoman(t *testing.T) {
+	tests := []struct {
+		s    string
+		want int
+	}{
+		{"I", 1},
+		{"IV", 4},
+		{"IX", 9},
+		{"XL", 40},
+		{"XC", 90},
+		{"CD", 400},
+		{"CM", 900},
+		{"MCMXCIV", 1994},
+		{"MMMCMXCIX", 3999},
+	}
+
+	for _, tc := range tests {
+		got, err := FromRoman(tc.s)
+		if err != nil {
+			t.Fatalf("FromRoman(%q): unexpected error: %v", tc.s, err)
+		}
+		if got != tc.want {
+			t.Fatalf("FromRoman(%q) = %d, want %d", tc.s, got, tc.want)
+		}
+	}
+}
+
+func TestFromRomanInvalid(t *testing.T) {
+	for _, s := range []string{"IIII", "IC", "VV", "", "ABC", "IXIX", "MMMM"} {
+		_, err := FromRoman(s)
+		if err == nil {
+			t.Fatalf("FromRoman(%q): expected error", s)
+		}
+		if _, ok := err.(*InvalidNumeralError); !ok {
+			t.Fatalf("FromRoman(%q): expected *InvalidNumeralError, got %T", s, err)
+		}
+	}
+}
+
+func TestRoundTrip(t *testing.T) {
+	for n := 1; n <= 3999; n++ {
+		roman, err := ToRoman(n)
+		if err != nil {
+			t.Fatalf("ToRoman(%d): %v", n, err)
+		}
+		back, err := FromRoman(roman)
+		if err != nil {
+			t.Fatalf("FromRoman(%q): %v", roman, err)
+		}
+		if back != n {
+			t.Fatalf("round trip for %d produced %q which parsed back to %d", n, roman, back)
+		}
+	}
+}
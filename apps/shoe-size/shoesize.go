@@ -0,0 +1,74 @@
+// Package shoesize converts shoe sizes between the US, UK and EU
+// sizing systems, each split into men's and women's charts since the
+// two are not simply offset from each other. Conversions are
+// approximate: real shoe sizing varies by brand and last, so results
+// should be treated as a guide rather than an exact fit.
+package shoesize
+
+import "fmt"
+
+// UnknownSystemError is returned when a system identifier is not one
+// of the supported "REGION-GENDER" identifiers.
+type UnknownSystemError struct {
+	System string
+}
+
+func (e *UnknownSystemError) Error() string {
+	return fmt.Sprintf("shoesize: unknown system %q", e.System)
+}
+
+// OutOfRangeError is returned when a size falls outside the range a
+// system's chart is defined for.
+type OutOfRangeError struct {
+	System string
+	Size   float64
+}
+
+func (e *OutOfRangeError) Error() string {
+	return fmt.Sprintf("shoesize: %v is out of range for system %q", e.Size, e.System)
+}
+
+// sizeChart describes one sizing system as a linear offset from a
+// common EU-equivalent scale, along with the range of sizes the chart
+// is considered valid for. Every system converts through euEquivalent
+// so adding a new system never requires a conversion between every
+// existing pair.
+type sizeChart struct {
+	euOffset float64 // euEquivalent = size + euOffset
+	min, max float64
+}
+
+// charts holds the documented approximate offsets for each supported
+// system, chosen so that e.g. US-M 9 lands on EU-M 42 and UK-M 8.5
+// lands on the same EU-M 42, matching commonly published conversion
+// tables.
+var charts = map[string]sizeChart{
+	"US-M": {euOffset: 33, min: 1, max: 20},
+	"US-W": {euOffset: 31.5, min: 1, max: 20},
+	"UK-M": {euOffset: 33.5, min: 0, max: 20},
+	"UK-W": {euOffset: 32.5, min: 0, max: 20},
+	"EU-M": {euOffset: 0, min: 16, max: 52},
+	"EU-W": {euOffset: 0, min: 16, max: 52},
+}
+
+// Convert converts size from one sizing system to another, e.g.
+// Convert(9, "US-M", "EU-M") returns a value close to 42. from and to
+// must be one of the identifiers in charts; a system that isn't one of
+// those returns an *UnknownSystemError, and a size outside the source
+// system's documented range returns an *OutOfRangeError.
+func Convert(size float64, from, to string) (float64, error) {
+	fromChart, ok := charts[from]
+	if !ok {
+		return 0, &UnknownSystemError{System: from}
+	}
+	toChart, ok := charts[to]
+	if !ok {
+		return 0, &UnknownSystemError{System: to}
+	}
+	if size < fromChart.min || size > fromChart.max {
+		return 0, &OutOfRangeError{System: from, Size: size}
+	}
+
+	euEquivalent := size + fromChart.euOffset
+	return euEquivalent - toChart.euOffset, nil
+}
@@ -0,0 +1,56 @@
+package shoesize
+
+import (
+	"errors"
+	"math"
+	"testing"
+)
+
+func assertClose(t *testing.T, got, want, tolerance float64) {
+	t.Helper()
+	if math.Abs(got-want) > tolerance {
+		t.Fatalf("got %v, want %v", got, want)
+	}
+}
+
+func TestConvert(t *testing.T) {
+	tests := []struct {
+		name string
+		size float64
+		from string
+		to   string
+		want float64
+	}{
+		{"US-M 9 to EU-M", 9, "US-M", "EU-M", 42},
+		{"UK-M 8.5 to EU-M", 8.5, "UK-M", "EU-M", 42},
+		{"US-W 8 to EU-W", 8, "US-W", "EU-W", 39.5},
+		{"EU-M to US-M round trip", 42, "EU-M", "US-M", 9},
+		{"same system is a no-op", 9, "US-M", "US-M", 9},
+	}
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			got, err := Convert(tc.size, tc.from, tc.to)
+			if err != nil {
+				t.Fatalf("unexpected error: %v", err)
+			}
+			assertClose(t, got, tc.want, 0.5)
+		})
+	}
+}
+
+func TestConvertUnknownSystem(t *testing.T) {
+	var target *UnknownSystemError
+	if _, err := Convert(9, "US-M", "XX-M"); !errors.As(err, &target) {
+		t.Fatalf("expected *UnknownSystemError, got %v", err)
+	}
+	if _, err := Convert(9, "XX-M", "EU-M"); !errors.As(err, &target) {
+		t.Fatalf("expected *UnknownSystemError, got %v", err)
+	}
+}
+
+func TestConvertOutOfRange(t *testing.T) {
+	var target *OutOfRangeError
+	if _, err := Convert(100, "US-M", "EU-M"); !errors.As(err, &target) {
+		t.Fatalf("expected *OutOfRangeError, got %v", err)
+	}
+}
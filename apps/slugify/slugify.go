@@ -0,0 +1,56 @@
+// Package slugify turns arbitrary text into URL-friendly slugs and
+// validates whether a string is already one.
+package slugify
+
+import (
+	"regexp"
+	"strings"
+)
+
+// transliterations maps common accented Latin letters to their
+// unaccented ASCII equivalent, e.g. 'é' -> 'e'. Anything not in this
+// table that also isn't already alphanumeric is dropped by
+// nonAlphanumeric instead of transliterated.
+var transliterations = map[rune]rune{
+	'à': 'a', 'á': 'a', 'â': 'a', 'ã': 'a', 'ä': 'a', 'å': 'a',
+	'è': 'e', 'é': 'e', 'ê': 'e', 'ë': 'e',
+	'ì': 'i', 'í': 'i', 'î': 'i', 'ï': 'i',
+	'ò': 'o', 'ó': 'o', 'ô': 'o', 'õ': 'o', 'ö': 'o',
+	'ù': 'u', 'ú': 'u', 'û': 'u', 'ü': 'u',
+	'ñ': 'n', 'ç': 'c', 'ý': 'y', 'ß': 's',
+}
+
+// nonAlphanumeric matches any run of characters that aren't a-z or 0-9,
+// once the input has been lowercased and transliterated.
+var nonAlphanumeric = regexp.MustCompile(`[^a-z0-9]+`)
+
+// slugPattern is the shape Slugify's output is supposed to always
+// match: lowercase alphanumeric words joined by single hyphens, with no
+// leading, trailing, or doubled hyphens.
+var slugPattern = regexp.MustCompile(`^[a-z0-9]+(-[a-z0-9]+)*$`)
+
+// Slugify lowercases s, transliterates common accented characters
+// (é -> e, ü -> u, ...), replaces every run of remaining
+// non-alphanumeric characters with a single hyphen, and trims leading
+// and trailing hyphens. An empty or all-symbol input produces an empty
+// slug rather than a bare "-".
+func Slugify(s string) string {
+	var sb strings.Builder
+	for _, r := range strings.ToLower(s) {
+		if t, ok := transliterations[r]; ok {
+			sb.WriteRune(t)
+		} else {
+			sb.WriteRune(r)
+		}
+	}
+
+	slug := nonAlphanumeric.ReplaceAllString(sb.String(), "-")
+	return strings.Trim(slug, "-")
+}
+
+// IsValidSlug reports whether s already has the shape Slugify produces:
+// lowercase alphanumeric words separated by single hyphens, with no
+// leading, trailing, or doubled hyphens.
+func IsValidSlug(s string) bool {
+	return slugPattern.MatchString(s)
+}
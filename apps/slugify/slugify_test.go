@@ -0,0 +1,54 @@
+package slugify
+
+import "testing"
+
+func TestSlugify(t *testing.T) {
+	tests := []struct {
+		input string
+		want  string
+	}{
+		{"Hello World", "hello-world"},
+		{"Café déjà vu", "cafe-deja-vu"},
+		{"München über alles", "munchen-uber-alles"},
+		{"Multiple   spaces   here", "multiple-spaces-here"},
+		{"  --Leading and trailing!!--  ", "leading-and-trailing"},
+		{"", ""},
+		{"!!!@@@###", ""},
+	}
+	for _, tc := range tests {
+		if got := Slugify(tc.input); got != tc.want {
+			t.Errorf("Slugify(%q) = %q, want %q", tc.input, got, tc.want)
+		}
+	}
+}
+
+func TestIsValidSlug(t *testing.T) {
+	tests := []struct {
+		input string
+		want  bool
+	}{
+		{"hello-world", true},
+		{"cafe-deja-vu", true},
+		{"a", true},
+		{"", false},
+		{"-hello-world", false},
+		{"hello-world-", false},
+		{"hello--world", false},
+		{"Hello-World", false},
+		{"hello_world", false},
+	}
+	for _, tc := range tests {
+		if got := IsValidSlug(tc.input); got != tc.want {
+			t.Errorf("IsValidSlug(%q) = %v, want %v", tc.input, got, tc.want)
+		}
+	}
+}
+
+func TestSlugifyProducesValidSlugs(t *testing.T) {
+	for _, input := range []string{"Hello World", "Café déjà vu", "a-b-c"} {
+		slug := Slugify(input)
+		if !IsValidSlug(slug) {
+			t.Errorf("Slugify(%q) = %q, which IsValidSlug rejects", input, slug)
+		}
+	}
+}
@@ -0,0 +1,95 @@
+// Package stats computes basic descriptive statistics over a slice of
+// float64s.
+package stats
+
+import (
+	"errors"
+	"math"
+	"sort"
+)
+
+// ErrEmptyInput is returned by every function in this package when
+// given an empty slice, since a mean, median, standard deviation, or
+// mode is undefined for no data (rather than returning NaN).
+var ErrEmptyInput = errors.New("stats: input must not be empty")
+
+// Mean returns the arithmetic mean of xs.
+func Mean(xs []float64) (float64, error) {
+	if len(xs) == 0 {
+		return 0, ErrEmptyInput
+	}
+	var sum float64
+	for _, x := range xs {
+		sum += x
+	}
+	return sum / float64(len(xs)), nil
+}
+
+// Median returns the median of xs: the middle value if len(xs) is
+// odd, or the mean of the two middle values if it's even. xs is not
+// modified.
+func Median(xs []float64) (float64, error) {
+	if len(xs) == 0 {
+		return 0, ErrEmptyInput
+	}
+	sorted := append([]float64(nil), xs...)
+	sort.Float64s(sorted)
+
+	mid := len(sorted) / 2
+	if len(sorted)%2 == 1 {
+		return sorted[mid], nil
+	}
+	return (sorted[mid-1] + sorted[mid]) / 2, nil
+}
+
+// StdDev returns the standard deviation of xs. When sample is true it
+// divides by N-1 (the sample variance, Bessel's correction) rather
+// than N (the population variance); the sample variant requires at
+// least two values.
+func StdDev(xs []float64, sample bool) (float64, error) {
+	if len(xs) == 0 {
+		return 0, ErrEmptyInput
+	}
+	if sample && len(xs) < 2 {
+		return 0, errors.New("stats: sample standard deviation requires at least two values")
+	}
+
+	mean, _ := Mean(xs)
+	var sumSquares float64
+	for _, x := range xs {
+		d := x - mean
+		sumSquares += d * d
+	}
+
+	n := float64(len(xs))
+	if sample {
+		n--
+	}
+	return math.Sqrt(sumSquares / n), nil
+}
+
+// Mode returns every value in xs that occurs most often, in ascending
+// order. If every value occurs exactly once, Mode returns all of them.
+func Mode(xs []float64) ([]float64, error) {
+	if len(xs) == 0 {
+		return nil, ErrEmptyInput
+	}
+
+	counts := make(map[float64]int, len(xs))
+	best := 0
+	for _, x := range xs {
+		counts[x]++
+		if counts[x] > best {
+			best = counts[x]
+		}
+	}
+
+	var modes []float64
+	for x, count := range counts {
+		if count == best {
+			modes = append(modes, x)
+		}
+	}
+	sort.Float64s(modes)
+	return modes, nil
+}
@@ -0,0 +1,103 @@
+package stats
+
+import (
+	"errors"
+	"math"
+	"testing"
+)
+
+func assertClose(t *testing.T, got, want, tol float64) {
+	t.Helper()
+	if math.Abs(got-want) > tol {
+		t.Fatalf("got %v, want %v", got, want)
+	}
+}
+
+var dataset = []float64{2, 4, 4, 4, 5, 5, 7, 9}
+
+func TestMean(t *testing.T) {
+	got, err := Mean(dataset)
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	assertClose(t, got, 5, 1e-9)
+}
+
+func TestMedian(t *testing.T) {
+	got, err := Median(dataset)
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	assertClose(t, got, 4.5, 1e-9)
+}
+
+func TestMedianOddLength(t *testing.T) {
+	got, err := Median([]float64{3, 1, 2})
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	assertClose(t, got, 2, 1e-9)
+}
+
+func TestStdDevPopulation(t *testing.T) {
+	got, err := StdDev(dataset, false)
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	assertClose(t, got, 2, 1e-9)
+}
+
+func TestStdDevSample(t *testing.T) {
+	got, err := StdDev(dataset, true)
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	assertClose(t, got, 2.138089935, 1e-6)
+}
+
+func TestStdDevSampleRequiresTwoValues(t *testing.T) {
+	if _, err := StdDev([]float64{1}, true); err == nil {
+		t.Fatal("expected an error for a single-value sample stddev")
+	}
+}
+
+func TestModeMultiModal(t *testing.T) {
+	got, err := Mode([]float64{1, 2, 2, 3, 3, 4})
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	want := []float64{2, 3}
+	if len(got) != len(want) {
+		t.Fatalf("Mode() = %v, want %v", got, want)
+	}
+	for i := range want {
+		if got[i] != want[i] {
+			t.Fatalf("Mode() = %v, want %v", got, want)
+		}
+	}
+}
+
+func TestModeSingleMode(t *testing.T) {
+	got, err := Mode(dataset)
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if len(got) != 1 || got[0] != 4 {
+		t.Fatalf("Mode(dataset) = %v, want [4]", got)
+	}
+}
+
+func TestEmptyInputErrors(t *testing.T) {
+	if _, err := Mean(nil); !errors.Is(err, ErrEmptyInput) {
+		t.Errorf("Mean(nil) error = %v, want ErrEmptyInput", err)
+	}
+	if _, err := Median(nil); !errors.Is(err, ErrEmptyInput) {
+		t.Errorf("Median(nil) error = %v, want ErrEmptyInput", err)
+	}
+	if _, err := StdDev(nil, false); !errors.Is(err, ErrEmptyInput) {
+		t.Errorf("StdDev(nil) error = %v, want ErrEmptyInput", err)
+	}
+	if _, err := Mode(nil); !errors.Is(err, ErrEmptyInput) {
+		t.Errorf("Mode(nil) error = %v, want ErrEmptyInput", err)
+	}
+}
@@ -0,0 +1,125 @@
+package stopwatch
+
+import (
+	"errors"
+	"testing"
+	"time"
+)
+
+// fakeClock is a Clock whose Now advances only when advance is called,
+// so tests can assert exact durations without sleeping.
+type fakeClock struct {
+	now time.Time
+}
+
+func (c *fakeClock) Now() time.Time { return c.now }
+
+func (c *fakeClock) advance(d time.Duration) { c.now = c.now.Add(d) }
+
+func TestStopwatchElapsed(t *testing.T) {
+	clock := &fakeClock{now: time.Unix(0, 0)}
+	sw := NewWithClock(clock)
+
+	sw.Start()
+	clock.advance(5 * time.Second)
+	if got := sw.Elapsed(); got != 5*time.Second {
+		t.Fatalf("Elapsed() while running = %v, want 5s", got)
+	}
+
+	if err := sw.Stop(); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	clock.advance(10 * time.Second)
+	if got := sw.Elapsed(); got != 5*time.Second {
+		t.Fatalf("Elapsed() after stop = %v, want 5s (time after stop should not count)", got)
+	}
+}
+
+func TestStopwatchMultipleSegments(t *testing.T) {
+	clock := &fakeClock{now: time.Unix(0, 0)}
+	sw := NewWithClock(clock)
+
+	sw.Start()
+	clock.advance(3 * time.Second)
+	if err := sw.Stop(); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	sw.Start()
+	clock.advance(2 * time.Second)
+	if err := sw.Stop(); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	if got := sw.Elapsed(); got != 5*time.Second {
+		t.Fatalf("Elapsed() across two segments = %v, want 5s", got)
+	}
+}
+
+func TestStopwatchStopBeforeStart(t *testing.T) {
+	sw := New()
+	if err := sw.Stop(); !errors.Is(err, ErrNotRunning) {
+		t.Fatalf("Stop() before Start = %v, want ErrNotRunning", err)
+	}
+}
+
+func TestStopwatchLapWhileStopped(t *testing.T) {
+	sw := New()
+	if _, err := sw.Lap(); !errors.Is(err, ErrNotRunning) {
+		t.Fatalf("Lap() while stopped = %v, want ErrNotRunning", err)
+	}
+}
+
+func TestStopwatchLapBoundaries(t *testing.T) {
+	clock := &fakeClock{now: time.Unix(0, 0)}
+	sw := NewWithClock(clock)
+
+	sw.Start()
+	clock.advance(2 * time.Second)
+	lap1, err := sw.Lap()
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if lap1 != 2*time.Second {
+		t.Fatalf("first lap = %v, want 2s", lap1)
+	}
+
+	clock.advance(3 * time.Second)
+	lap2, err := sw.Lap()
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if lap2 != 3*time.Second {
+		t.Fatalf("second lap = %v, want 3s", lap2)
+	}
+}
+
+func TestStopwatchReset(t *testing.T) {
+	clock := &fakeClock{now: time.Unix(0, 0)}
+	sw := NewWithClock(clock)
+
+	sw.Start()
+	clock.advance(5 * time.Second)
+	sw.Reset()
+
+	if got := sw.Elapsed(); got != 0 {
+		t.Fatalf("Elapsed() after Reset() = %v, want 0", got)
+	}
+	if err := sw.Stop(); !errors.Is(err, ErrNotRunning) {
+		t.Fatalf("Stop() after Reset() = %v, want ErrNotRunning", err)
+	}
+}
+
+func TestStopwatchStartWhileRunningIsNoOp(t *testing.T) {
+	clock := &fakeClock{now: time.Unix(0, 0)}
+	sw := NewWithClock(clock)
+
+	sw.Start()
+	clock.advance(2 * time.Second)
+	sw.Start() // should not reset the start time
+	clock.advance(3 * time.Second)
+
+	if got := sw.Elapsed(); got != 5*time.Second {
+		t.Fatalf("Elapsed() = %v, want 5s", got)
+	}
+}
@@ -0,0 +1,136 @@
+// Package textstats computes basic readability metrics -- word, sentence,
+// and character counts, estimated reading time, and the Flesch
+// reading-ease score -- for a block of text.
+package textstats
+
+import (
+	"strings"
+	"unicode"
+)
+
+// DefaultWPM is the reading speed Analyze assumes when estimating
+// reading time, based on the commonly cited average adult silent
+// reading speed.
+const DefaultWPM = 200
+
+// Stats holds the counts and derived metrics Analyze computes for a
+// piece of text.
+type Stats struct {
+	Words              int
+	Sentences          int
+	Characters         int
+	CharactersNoSpaces int
+	ReadingTimeSeconds float64
+}
+
+// Analyze computes Stats for text at the DefaultWPM reading speed. An
+// empty (or all-whitespace) text returns a zero Stats rather than
+// panicking.
+func Analyze(text string) Stats {
+	return AnalyzeWPM(text, DefaultWPM)
+}
+
+// AnalyzeWPM is like Analyze but estimates ReadingTimeSeconds at wpm
+// words per minute instead of DefaultWPM, for callers that want to
+// tailor the estimate to a particular audience.
+func AnalyzeWPM(text string, wpm int) Stats {
+	words := wordCount(text)
+	sentences := sentenceCount(text)
+
+	var characters, charactersNoSpaces int
+	for _, r := range text {
+		characters++
+		if !unicode.IsSpace(r) {
+			charactersNoSpaces++
+		}
+	}
+
+	var readingSeconds float64
+	if words > 0 && wpm > 0 {
+		readingSeconds = float64(words) / float64(wpm) * 60
+	}
+
+	return Stats{
+		Words:              words,
+		Sentences:          sentences,
+		Characters:         characters,
+		CharactersNoSpaces: charactersNoSpaces,
+		ReadingTimeSeconds: readingSeconds,
+	}
+}
+
+// FleschScore returns text's Flesch reading-ease score: roughly, higher
+// scores (up to ~100) are easier to read and lower or negative scores
+// are harder. Text with no words or no sentences scores 0 rather than
+// dividing by zero.
+func FleschScore(text string) float64 {
+	words := strings.Fields(text)
+	sentences := sentenceCount(text)
+	if len(words) == 0 || sentences == 0 {
+		return 0
+	}
+
+	syllables := 0
+	for _, w := range words {
+		syllables += countSyllables(w)
+	}
+
+	wordsPerSentence := float64(len(words)) / float64(sentences)
+	syllablesPerWord := float64(syllables) / float64(len(words))
+	return 206.835 - 1.015*wordsPerSentence - 84.6*syllablesPerWord
+}
+
+// wordCount returns the number of whitespace-separated words in text.
+func wordCount(text string) int {
+	return len(strings.Fields(text))
+}
+
+// sentenceCount returns the number of sentences in text, delimited by
+// '.', '!', or '?'. Consecutive delimiters and leading/trailing
+// whitespace don't produce extra or empty sentences.
+func sentenceCount(text string) int {
+	count := 0
+	inSentence := false
+	for _, r := range text {
+		switch {
+		case r == '.' || r == '!' || r == '?':
+			if inSentence {
+				count++
+				inSentence = false
+			}
+		case unicode.IsSpace(r):
+			// whitespace doesn't end or start a sentence on its own
+		default:
+			inSentence = true
+		}
+	}
+	if inSentence {
+		count++
+	}
+	return count
+}
+
+// countSyllables estimates the number of syllables in word by counting
+// runs of consecutive vowels, a common heuristic that's accurate enough
+// for a readability score without a full pronunciation dictionary. A
+// trailing silent "e" is not counted as its own syllable, and every
+// word has at least one syllable.
+func countSyllables(word string) int {
+	word = strings.ToLower(word)
+	count := 0
+	prevVowel := false
+	for _, r := range word {
+		isVowel := strings.ContainsRune("aeiouy", r)
+		if isVowel && !prevVowel {
+			count++
+		}
+		prevVowel = isVowel
+	}
+	if strings.HasSuffix(word, "e") && count > 1 {
+		count--
+	}
+	if count == 0 {
+		count = 1
+	}
+	return count
+}
@@ -0,0 +1,60 @@
+package textstats
+
+import "testing"
+
+func TestAnalyze(t *testing.T) {
+	text := "The quick brown fox jumps over the lazy dog. It runs fast!"
+	stats := Analyze(text)
+
+	if stats.Words != 12 {
+		t.Errorf("Words = %d, want 12", stats.Words)
+	}
+	if stats.Sentences != 2 {
+		t.Errorf("Sentences = %d, want 2", stats.Sentences)
+	}
+	if stats.Characters != len([]rune(text)) {
+		t.Errorf("Characters = %d, want %d", stats.Characters, len([]rune(text)))
+	}
+	if stats.CharactersNoSpaces == 0 || stats.CharactersNoSpaces >= stats.Characters {
+		t.Errorf("CharactersNoSpaces = %d, want between 0 and %d", stats.CharactersNoSpaces, stats.Characters)
+	}
+	if stats.ReadingTimeSeconds <= 0 {
+		t.Errorf("ReadingTimeSeconds = %v, want > 0", stats.ReadingTimeSeconds)
+	}
+}
+
+func TestAnalyzeEmpty(t *testing.T) {
+	stats := Analyze("")
+	want := Stats{}
+	if stats != want {
+		t.Errorf("Analyze(\"\") = %+v, want %+v", stats, want)
+	}
+}
+
+func TestAnalyzeWPM(t *testing.T) {
+	text := "one two three four five six seven eight nine ten."
+	fast := AnalyzeWPM(text, 600)
+	slow := AnalyzeWPM(text, 60)
+	if fast.ReadingTimeSeconds >= slow.ReadingTimeSeconds {
+		t.Errorf("expected a higher WPM to yield a shorter reading time, got fast=%v slow=%v",
+			fast.ReadingTimeSeconds, slow.ReadingTimeSeconds)
+	}
+}
+
+func TestFleschScore(t *testing.T) {
+	simple := "The cat sat. The dog ran."
+	complex := "Notwithstanding the aforementioned considerations, the multifaceted implications necessitate comprehensive deliberation."
+
+	simpleScore := FleschScore(simple)
+	complexScore := FleschScore(complex)
+	if simpleScore <= complexScore {
+		t.Errorf("expected simple text to score higher than complex text, got simple=%v complex=%v",
+			simpleScore, complexScore)
+	}
+}
+
+func TestFleschScoreEmpty(t *testing.T) {
+	if got := FleschScore(""); got != 0 {
+		t.Errorf("FleschScore(\"\") = %v, want 0", got)
+	}
+}
@@ -0,0 +1,46 @@
+// Package timestampconverter converts between Unix epoch timestamps
+// and RFC3339 strings.
+package timestampconverter
+
+import (
+	"fmt"
+	"time"
+)
+
+// EpochToRFC3339 renders seconds (a Unix epoch timestamp) as an
+// RFC3339 string in loc, e.g. EpochToRFC3339(0, time.UTC) returns
+// "1970-01-01T00:00:00Z". loc is explicit rather than defaulted to
+// time.Local so the result doesn't silently depend on the host's
+// timezone configuration.
+func EpochToRFC3339(seconds int64, loc *time.Location) string {
+	return time.Unix(seconds, 0).In(loc).Format(time.RFC3339)
+}
+
+// EpochMilliToRFC3339 is like EpochToRFC3339 but takes milliseconds
+// since the epoch and renders fractional seconds when s isn't a whole
+// number of seconds.
+func EpochMilliToRFC3339(milliseconds int64, loc *time.Location) string {
+	return time.UnixMilli(milliseconds).In(loc).Format(time.RFC3339Nano)
+}
+
+// RFC3339ToEpoch parses s as an RFC3339 string (which may carry any
+// UTC offset) and returns the corresponding Unix epoch timestamp in
+// seconds. A string that doesn't parse as RFC3339 returns a clear
+// error naming the offending input.
+func RFC3339ToEpoch(s string) (int64, error) {
+	t, err := time.Parse(time.RFC3339, s)
+	if err != nil {
+		return 0, fmt.Errorf("timestampconverter: %q is not a valid RFC3339 timestamp: %w", s, err)
+	}
+	return t.Unix(), nil
+}
+
+// RFC3339ToEpochMilli is like RFC3339ToEpoch but returns milliseconds
+// since the epoch, and accepts fractional seconds in s.
+func RFC3339ToEpochMilli(s string) (int64, error) {
+	t, err := time.Parse(time.RFC3339Nano, s)
+	if err != nil {
+		return 0, fmt.Errorf("timestampconverter: %q is not a valid RFC3339 timestamp: %w", s, err)
+	}
+	return t.UnixMilli(), nil
+}
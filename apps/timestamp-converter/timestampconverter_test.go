@@ -0,0 +1,74 @@
+package timestampconverter
+
+import (
+	"testing"
+	"time"
+)
+
+func TestEpochToRFC3339(t *testing.T) {
+	if got, want := EpochToRFC3339(0, time.UTC), "1970-01-01T00:00:00Z"; got != want {
+		t.Fatalf("EpochToRFC3339(0, UTC) = %q, want %q", got, want)
+	}
+}
+
+func TestEpochToRFC3339WithOffset(t *testing.T) {
+	loc := time.FixedZone("UTC-5", -5*60*60)
+	got := EpochToRFC3339(0, loc)
+	want := "1969-12-31T19:00:00-05:00"
+	if got != want {
+		t.Fatalf("EpochToRFC3339(0, UTC-5) = %q, want %q", got, want)
+	}
+}
+
+func TestRFC3339ToEpoch(t *testing.T) {
+	got, err := RFC3339ToEpoch("1970-01-01T00:00:00Z")
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if got != 0 {
+		t.Fatalf("RFC3339ToEpoch(...) = %d, want 0", got)
+	}
+}
+
+func TestRFC3339ToEpochMalformed(t *testing.T) {
+	if _, err := RFC3339ToEpoch("not a timestamp"); err == nil {
+		t.Fatal("expected an error for a malformed RFC3339 string")
+	}
+}
+
+func TestEpochRFC3339RoundTrip(t *testing.T) {
+	const original int64 = 1700000000
+	s := EpochToRFC3339(original, time.UTC)
+	back, err := RFC3339ToEpoch(s)
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if back != original {
+		t.Fatalf("round trip = %d, want %d", back, original)
+	}
+}
+
+func TestEpochMilliToRFC3339(t *testing.T) {
+	got := EpochMilliToRFC3339(1500, time.UTC)
+	if got != "1970-01-01T00:00:01.5Z" {
+		t.Fatalf("EpochMilliToRFC3339(1500, UTC) = %q, want %q", got, "1970-01-01T00:00:01.5Z")
+	}
+}
+
+func TestRFC3339ToEpochMilliRoundTrip(t *testing.T) {
+	const original int64 = 1700000000123
+	s := EpochMilliToRFC3339(original, time.UTC)
+	back, err := RFC3339ToEpochMilli(s)
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if back != original {
+		t.Fatalf("round trip = %d, want %d", back, original)
+	}
+}
+
+func TestRFC3339ToEpochMilliMalformed(t *testing.T) {
+	if _, err := RFC3339ToEpochMilli("not a timestamp"); err == nil {
+		t.Fatal("expected an error for a malformed RFC3339 string")
+	}
+}
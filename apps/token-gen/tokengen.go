@@ -0,0 +1,74 @@
+// Package tokengen generates cryptographically secure random tokens
+// for use as API keys, session identifiers, and similar secrets.
+package tokengen
+
+import (
+	"crypto/rand"
+	"fmt"
+	"math/big"
+)
+
+// InvalidLengthError is returned by Token when length isn't positive.
+type InvalidLengthError struct {
+	Length int
+}
+
+func (e *InvalidLengthError) Error() string {
+	return fmt.Sprintf("tokengen: length must be positive, got %d", e.Length)
+}
+
+// EmptyCharsetError is returned by Token when the named or custom
+// charset resolves to no characters.
+type EmptyCharsetError struct {
+	Charset string
+}
+
+func (e *EmptyCharsetError) Error() string {
+	return fmt.Sprintf("tokengen: charset %q is empty", e.Charset)
+}
+
+// namedCharsets maps the charset names Token accepts to the actual
+// characters it draws from. Any other charset string is used verbatim
+// as a custom set of characters.
+var namedCharsets = map[string]string{
+	"alnum":     "ABCDEFGHIJKLMNOPQRSTUVWXYZabcdefghijklmnopqrstuvwxyz0123456789",
+	"hex":       "0123456789abcdef",
+	"base64url": "ABCDEFGHIJKLMNOPQRSTUVWXYZabcdefghijklmnopqrstuvwxyz0123456789-_",
+}
+
+// Token returns a random string of length characters drawn uniformly
+// from charset, using crypto/rand so the result is suitable for use as
+// a secret. charset is resolved against namedCharsets ("alnum", "hex",
+// "base64url"); any other string is used as a literal, custom set of
+// characters. length must be positive, and the resolved charset must
+// not be empty.
+func Token(length int, charset string) (string, error) {
+	if length <= 0 {
+		return "", &InvalidLengthError{Length: length}
+	}
+
+	runes := []rune(resolveCharset(charset))
+	if len(runes) == 0 {
+		return "", &EmptyCharsetError{Charset: charset}
+	}
+
+	result := make([]rune, length)
+	max := big.NewInt(int64(len(runes)))
+	for i := range result {
+		n, err := rand.Int(rand.Reader, max)
+		if err != nil {
+			return "", fmt.Errorf("tokengen: failed to read random bytes: %w", err)
+		}
+		result[i] = runes[n.Int64()]
+	}
+	return string(result), nil
+}
+
+// resolveCharset returns the named charset's characters, or charset
+// itself if it isn't a recognized name.
+func resolveCharset(charset string) string {
+	if named, ok := namedCharsets[charset]; ok {
+		return named
+	}
+	return charset
+}
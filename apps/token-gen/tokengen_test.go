@@ -0,0 +1,67 @@
+package tokengen
+
+import (
+	"errors"
+	"strings"
+	"testing"
+)
+
+func TestTokenLength(t *testing.T) {
+	tok, err := Token(16, "alnum")
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if len(tok) != 16 {
+		t.Fatalf("len(Token(16, alnum)) = %d, want 16", len(tok))
+	}
+}
+
+func TestTokenOnlyUsesCharset(t *testing.T) {
+	tests := []struct {
+		charset string
+		valid   string
+	}{
+		{"hex", "0123456789abcdef"},
+		{"base64url", namedCharsets["base64url"]},
+		{"ABC", "ABC"},
+	}
+	for _, tc := range tests {
+		tok, err := Token(200, tc.charset)
+		if err != nil {
+			t.Fatalf("Token(200, %q) returned error: %v", tc.charset, err)
+		}
+		for _, r := range tok {
+			if !strings.ContainsRune(tc.valid, r) {
+				t.Fatalf("Token(200, %q) contained %q, not in charset", tc.charset, r)
+			}
+		}
+	}
+}
+
+func TestTokenTwoCallsDiffer(t *testing.T) {
+	a, err := Token(32, "alnum")
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	b, err := Token(32, "alnum")
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if a == b {
+		t.Fatalf("two successive 32-character tokens collided: %q", a)
+	}
+}
+
+func TestTokenInvalidLength(t *testing.T) {
+	var target *InvalidLengthError
+	if _, err := Token(0, "alnum"); !errors.As(err, &target) {
+		t.Fatalf("expected *InvalidLengthError, got %v", err)
+	}
+}
+
+func TestTokenEmptyCharset(t *testing.T) {
+	var target *EmptyCharsetError
+	if _, err := Token(8, ""); !errors.As(err, &target) {
+		t.Fatalf("expected *EmptyCharsetError, got %v", err)
+	}
+}
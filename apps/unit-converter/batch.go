@@ -0,0 +1,44 @@
+package converter
+
+import "fmt"
+
+// BatchRequest is a single conversion to run as part of ConvertBatch.
+type BatchRequest struct {
+	Kind  string
+	Value float64
+}
+
+// ConvertBatch runs Convert on each request in order, returning the
+// results in the same order. If any request fails, ConvertBatch stops
+// and returns an error wrapping the failure with the index that broke,
+// e.g. "request 2: ...".
+func ConvertBatch(reqs []BatchRequest) ([]float64, error) {
+	results := make([]float64, len(reqs))
+	for i, req := range reqs {
+		result, err := Convert(req.Kind, req.Value)
+		if err != nil {
+			return nil, fmt.Errorf("request %d: %w", i, err)
+		}
+		results[i] = result
+	}
+	return results, nil
+}
+
+// ConvertBatchAll runs Convert on each request in order like
+// ConvertBatch, but never stops at the first failure. It returns
+// results and errs, both the same length as reqs: a failed request
+// leaves its zero value in results and records the failure in errs at
+// the same index, while a successful request leaves a nil error.
+func ConvertBatchAll(reqs []BatchRequest) (results []float64, errs []error) {
+	results = make([]float64, len(reqs))
+	errs = make([]error, len(reqs))
+	for i, req := range reqs {
+		result, err := Convert(req.Kind, req.Value)
+		if err != nil {
+			errs[i] = fmt.Errorf("request %d: %w", i, err)
+			continue
+		}
+		results[i] = result
+	}
+	return results, errs
+}
@@ -0,0 +1,50 @@
+package converter
+
+import "math"
+
+// decimalByteUnits are the SI (base-1000) byte-size prefixes FormatBytes
+// picks from when binary is false.
+var decimalByteUnits = []string{"B", "KB", "MB", "GB", "TB", "PB", "EB"}
+
+// binaryByteUnits are the IEC (base-1024) byte-size prefixes FormatBytes
+// picks from when binary is true.
+var binaryByteUnits = []string{"B", "KiB", "MiB", "GiB", "TiB", "PiB", "EiB"}
+
+// FormatBytes renders bytes as a human-readable size, e.g.
+// FormatBytes(1536, false) returns "1.5 KB" and FormatBytes(1048576,
+// true) returns "1 MiB". binary selects base-1024 IEC prefixes (KiB,
+// MiB, ...) instead of base-1000 SI ones (KB, MB, ...). The result is
+// rounded to one decimal place, and a negative bytes is rendered with a
+// leading "-" rather than treated as an error.
+func FormatBytes(bytes int64, binary bool) string {
+	if bytes == 0 {
+		return "0 B"
+	}
+
+	negative := bytes < 0
+	n := bytes
+	if negative {
+		n = -n
+	}
+
+	base := 1000.0
+	units := decimalByteUnits
+	if binary {
+		base = 1024.0
+		units = binaryByteUnits
+	}
+
+	value := float64(n)
+	idx := 0
+	for value >= base && idx < len(units)-1 {
+		value /= base
+		idx++
+	}
+
+	rounded := math.Round(value*10) / 10
+	s := formatValue(rounded)
+	if negative {
+		s = "-" + s
+	}
+	return s + " " + units[idx]
+}
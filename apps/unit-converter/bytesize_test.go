@@ -0,0 +1,24 @@
+package converter
+
+import "testing"
+
+func TestFormatBytes(t *testing.T) {
+	tests := []struct {
+		bytes  int64
+		binary bool
+		want   string
+	}{
+		{0, false, "0 B"},
+		{0, true, "0 B"},
+		{1536, false, "1.5 KB"},
+		{1048576, true, "1 MiB"},
+		{-1536, false, "-1.5 KB"},
+		{5_000_000_000_000_000_000, false, "5 EB"},
+	}
+	for _, tc := range tests {
+		got := FormatBytes(tc.bytes, tc.binary)
+		if got != tc.want {
+			t.Errorf("FormatBytes(%d, %v) = %q, want %q", tc.bytes, tc.binary, got, tc.want)
+		}
+	}
+}
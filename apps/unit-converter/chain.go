@@ -0,0 +1,25 @@
+package converter
+
+import "fmt"
+
+// ConvertChain applies each "from2to" keyword in kinds to value in
+// sequence, feeding each step's result into the next, e.g.
+// ConvertChain([]string{"m2ft", "ft2in"}, 1) converts meters to feet
+// and then feet to inches. The returned slice has len(kinds)+1
+// elements: the input value followed by the result after every step,
+// so callers can inspect intermediate values rather than only the
+// final one. If a step fails, ConvertChain stops and returns an error
+// wrapping the failure with the index of the step that broke, e.g.
+// "step 1: ...".
+func ConvertChain(kinds []string, value float64) ([]float64, error) {
+	results := make([]float64, len(kinds)+1)
+	results[0] = value
+	for i, kind := range kinds {
+		result, err := Convert(kind, results[i])
+		if err != nil {
+			return nil, fmt.Errorf("step %d: %w", i, err)
+		}
+		results[i+1] = result
+	}
+	return results, nil
+}
@@ -0,0 +1,114 @@
+// Command convertcsv batch-converts a CSV file of conversions:
+//
+//	convertcsv -in requests.csv -out results.csv
+//
+// The input CSV has columns "kind,value"; the output CSV adds a
+// "result" column, and an "error" column for rows that fail, so one bad
+// row doesn't abort the whole file. -in and -out default to stdin and
+// stdout.
+package main
+
+import (
+	"encoding/csv"
+	"flag"
+	"fmt"
+	"io"
+	"os"
+	"strconv"
+
+	converter "github.com/richoandika/community-toolbox/apps/unit-converter"
+)
+
+func main() {
+	inPath := flag.String("in", "", "input CSV file (default: stdin)")
+	outPath := flag.String("out", "", "output CSV file (default: stdout)")
+	flag.Parse()
+
+	in := io.Reader(os.Stdin)
+	if *inPath != "" {
+		f, err := os.Open(*inPath)
+		if err != nil {
+			fmt.Fprintf(os.Stderr, "convertcsv: %v\n", err)
+			os.Exit(1)
+		}
+		defer f.Close()
+		in = f
+	}
+
+	out := io.Writer(os.Stdout)
+	if *outPath != "" {
+		f, err := os.Create(*outPath)
+		if err != nil {
+			fmt.Fprintf(os.Stderr, "convertcsv: %v\n", err)
+			os.Exit(1)
+		}
+		defer f.Close()
+		out = f
+	}
+
+	if err := Run(in, out); err != nil {
+		fmt.Fprintf(os.Stderr, "convertcsv: %v\n", err)
+		os.Exit(1)
+	}
+}
+
+// Run reads a "kind,value" CSV from r and writes a "kind,value,result,error"
+// CSV to w, converting each row with converter.Convert. A row whose value
+// fails to parse or whose conversion fails gets an empty result and a
+// populated error column instead of aborting the rest of the file.
+func Run(r io.Reader, w io.Writer) error {
+	reader := csv.NewReader(r)
+	header, err := reader.Read()
+	if err != nil {
+		return fmt.Errorf("reading header: %w", err)
+	}
+	if len(header) < 2 || header[0] != "kind" || header[1] != "value" {
+		return fmt.Errorf("expected header \"kind,value\", got %v", header)
+	}
+
+	writer := csv.NewWriter(w)
+	if err := writer.Write([]string{"kind", "value", "result", "error"}); err != nil {
+		return err
+	}
+
+	for {
+		row, err := reader.Read()
+		if err == io.EOF {
+			break
+		}
+		if err != nil {
+			return err
+		}
+		if len(row) < 2 {
+			return fmt.Errorf("row %v: expected at least 2 columns", row)
+		}
+
+		kind, valueStr := row[0], row[1]
+		result, resultErr := convertRow(kind, valueStr)
+
+		resultStr, errStr := "", ""
+		if resultErr != nil {
+			errStr = resultErr.Error()
+		} else {
+			resultStr = strconv.FormatFloat(result, 'g', -1, 64)
+		}
+
+		if err := writer.Write([]string{kind, valueStr, resultStr, errStr}); err != nil {
+			return err
+		}
+	}
+
+	writer.Flush()
+	return writer.Error()
+}
+
+// convertRow parses valueStr and converts it via kind, returning any
+// parse or conversion error as a single error so Run can report it in
+// the output CSV's error column.
+func convertRow(kind, valueStr string) (float64, error) {
+	value, err := strconv.ParseFloat(valueStr, 64)
+	if err != nil {
+		return 0, fmt.Errorf("%q is not a number", valueStr)
+	}
+	return converter.Convert(kind, value)
+}
@@ -0,0 +1,55 @@
+package main
+
+import (
+	"bytes"
+	"strings"
+	"testing"
+)
+
+func TestRunPreservesOrderAndReportsErrors(t *testing.T) {
+	input := "kind,value\nm2ft,1\nbogus,1\nm2ft,notanumber\nkg2lb,2\n"
+	var out bytes.Buffer
+
+	if err := Run(strings.NewReader(input), &out); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	lines := strings.Split(strings.TrimRight(out.String(), "\n"), "\n")
+	if len(lines) != 5 {
+		t.Fatalf("got %d output lines, want 5 (header + 4 rows): %q", len(lines), out.String())
+	}
+	if lines[0] != "kind,value,result,error" {
+		t.Fatalf("unexpected header: %q", lines[0])
+	}
+
+	// Row order must match input order.
+	wantPrefixes := []string{"m2ft,1,", "bogus,1,", "m2ft,notanumber,", "kg2lb,2,"}
+	for i, want := range wantPrefixes {
+		if !strings.HasPrefix(lines[i+1], want) {
+			t.Fatalf("row %d = %q, want prefix %q", i+1, lines[i+1], want)
+		}
+	}
+
+	// The two bad rows have a populated error column and no result.
+	if !strings.Contains(lines[2], ",,") {
+		t.Fatalf("expected empty result for unconvertible row, got %q", lines[2])
+	}
+	if !strings.Contains(lines[3], ",,") {
+		t.Fatalf("expected empty result for malformed value row, got %q", lines[3])
+	}
+
+	// The two good rows have a result and no trailing error text.
+	if !strings.HasSuffix(lines[1], ",") {
+		t.Fatalf("expected no error text for valid row, got %q", lines[1])
+	}
+	if !strings.HasSuffix(lines[4], ",") {
+		t.Fatalf("expected no error text for valid row, got %q", lines[4])
+	}
+}
+
+func TestRunBadHeader(t *testing.T) {
+	var out bytes.Buffer
+	if err := Run(strings.NewReader("foo,bar\n"), &out); err == nil {
+		t.Fatal("expected error for bad header")
+	}
+}
@@ -0,0 +1,42 @@
+package converter
+
+import "fmt"
+
+// CompoundLengthMismatchError is returned by ConvertCompound when
+// fromUnits and toUnits have a different number of factors, so there is
+// no way to pair them up position by position.
+type CompoundLengthMismatchError struct {
+	FromLen, ToLen int
+}
+
+func (e *CompoundLengthMismatchError) Error() string {
+	return fmt.Sprintf("converter: compound unit has %d factor(s) but target has %d", e.FromLen, e.ToLen)
+}
+
+// ConvertCompound converts value expressed as the product of the units
+// in fromUnits into the product of the units in toUnits, e.g.
+// ConvertCompound([]string{"n", "m"}, []string{"lbf", "ft"}, value)
+// converts a newton-metre torque into pound-force-feet. Each pair
+// fromUnits[i]/toUnits[i] is converted independently by multiplying the
+// per-unit registry factors together, so this only works for products
+// (not quotients) of units whose conversions are pure scale factors.
+//
+// fromUnits and toUnits must be the same length, and each pair must
+// share a dimension (in whatever order they're given); otherwise a
+// *CompoundLengthMismatchError or *CrossDimensionError is returned. An
+// unrecognized unit name returns an UnknownUnitError.
+func ConvertCompound(fromUnits, toUnits []string, value float64) (float64, error) {
+	if len(fromUnits) != len(toUnits) {
+		return 0, &CompoundLengthMismatchError{FromLen: len(fromUnits), ToLen: len(toUnits)}
+	}
+
+	result := value
+	for i := range fromUnits {
+		converted, err := defaultRegistry.ConvertUnits(fromUnits[i], toUnits[i], 1)
+		if err != nil {
+			return 0, err
+		}
+		result *= converted
+	}
+	return result, nil
+}
@@ -1,29 +1,371 @@
+// Package converter provides unit conversion built on a pluggable
+// Registry: each dimension (length, temperature, ...) has a base unit,
+// and every registered unit only needs to know how to convert to and
+// from that base, so adding a unit never requires touching Convert.
 package converter
 
-import "errors"
+import (
+	"errors"
+	"fmt"
+	"math"
+	"sort"
+	"strings"
+)
+
+// Angle conversion factors, expressed per radian (the dimension's base
+// unit): a degree is π/180 rad, and a gradian is π/200 rad (400 grad
+// per full turn, vs 2π rad). Convert never normalizes an angle into a
+// canonical range, so e.g. 720° converts straight to 4π rad.
+const (
+	degToRadFactor  = math.Pi / 180
+	radToDegFactor  = 1 / degToRadFactor
+	gradToRadFactor = math.Pi / 200
+	radToGradFactor = 1 / gradToRadFactor
+)
+
+// mpgToLper100kmConstant relates US MPG and L/100km by a reciprocal,
+// not a linear factor: mpg = mpgToLper100kmConstant / lper100km, and
+// the relationship is its own inverse.
+const mpgToLper100kmConstant = 235.214583
 
 const (
 	metersToFeetFactor = 3.28084
 	feetToMetersFactor = 0.3048
+	inToMetersFactor   = 0.0254
+	metersToInFactor   = 1 / inToMetersFactor
+
+	kgToLbFactor = 2.2046226218
+	lbToKgFactor = 1 / kgToLbFactor
+	ozToGFactor  = 28.349523125
+	gToOzFactor  = 1 / ozToGFactor
+	gToKgFactor  = 0.001
+	kgToGFactor  = 1 / gToKgFactor
+	ozToKgFactor = ozToGFactor * gToKgFactor
+	kgToOzFactor = 1 / ozToKgFactor
+	mgToKgFactor = 1e-6
+	kgToMgFactor = 1 / mgToKgFactor
+	tToKgFactor  = 1000.0
+	kgToTFactor  = 1 / tToKgFactor
+
+	// US gallon: the registry's default "gal"/"floz" keywords are US
+	// customary units, not imperial ones; see units.go for "impgal".
+	usGalToLitersFactor  = 3.785411784
+	litersToUSGalFactor  = 1 / usGalToLitersFactor
+	usFlozToMLFactor     = 29.5735295625
+	mlToUSFlozFactor     = 1 / usFlozToMLFactor
+	usFlozToLitersFactor = usFlozToMLFactor / 1000
+	litersToUSFlozFactor = 1 / usFlozToLitersFactor
+
+	impGalToLitersFactor  = 4.54609
+	litersToImpGalFactor  = 1 / impGalToLitersFactor
+	impFlozToMLFactor     = 28.4130625
+	impFlozToLitersFactor = impFlozToMLFactor / 1000
+	litersToImpFlozFactor = 1 / impFlozToLitersFactor
+)
+
+// lbfToNFactor is the pound-force, defined via standard gravity
+// (9.80665 m/s^2) acting on one avoirdupois pound (0.45359237 kg), so it
+// is exact rather than a measured approximation.
+const (
+	lbfToNFactor = 4.4482216152605
+	nToLbfFactor = 1 / lbfToNFactor
+)
+
+// Speed conversion factors are exported so other code that already
+// deals in meters-per-second (the speed dimension's base unit) can
+// reuse the exact figures used internally, rather than redeclaring
+// them and risking drift.
+const (
+	MPHToMSFactor = 0.44704
+	MSToMPHFactor = 1 / MPHToMSFactor
+	KMHToMSFactor = 0.277778
+	MSToKMHFactor = 1 / KMHToMSFactor
 )
 
-// Convert applies the given conversion keyword to the value.
-// Supported conversions:
-//   - "m2ft": meters to feet
-//   - "ft2m": feet to meters
-//   - "c2f": Celsius to Fahrenheit
-//   - "f2c": Fahrenheit to Celsius
+// Convert applies a legacy "from2to" conversion keyword to value, e.g.
+// "m2ft" or "c2f". It is a thin wrapper around ConvertUnits kept for
+// backward compatibility; new code should prefer ConvertUnits or
+// Register directly against a Registry.
+//
+// Volume keywords distinguish US customary units from imperial ones:
+//
+//	gal, floz       US gallon (3.785411784 L), US fl oz (29.5735295625 mL)
+//	impgal, impfloz imperial gallon (4.54609 L), imperial fl oz (28.4130625 mL)
+//
+// cup, tbsp, and tsp are US customary cooking measures (1 cup =
+// 236.588 mL), not metric ones (a metric cup is 250 mL).
+//
+// Data-storage keywords distinguish decimal (SI, base-1000: kb, mb, gb)
+// from binary (IEC, base-1024: kib, mib, gib) units; a keyword like
+// "mb2mib" is offered deliberately, applying the 1e6/1048576 ratio,
+// rather than treating 1 MB and 1 MiB as interchangeable.
+//
+// Time-duration keywords are calendar-agnostic: a day is always
+// 86400 seconds, with no leap seconds or DST adjustments.
+//
+// Angle keywords never normalize into a canonical range: converting
+// 720° gives 4π rad, not 0.
+//
+// Pressure keywords use the standard atmosphere as defined by the
+// 1954 10th Conférence Générale des Poids et Mesures: 1 atm is exactly
+// 101325 Pa.
+//
+// Energy keywords use the thermochemical calorie (1 cal = 4.184 J
+// exactly), not the slightly larger international steam-table calorie.
+//
+// mpg2lper100km and lper100km2mpg relate by a reciprocal, not a linear
+// factor (235.214583 / value), and use US gallons, not imperial. A
+// non-positive value returns a *NonPositiveValueError.
+//
+// value must be finite: NaN or either infinity returns an error rather
+// than propagating garbage into the conversion and any downstream
+// formatting.
+//
+// If kind is not a built-in conversion, Convert falls back to any
+// Converter registered for kind via RegisterConverter before giving
+// up, so callers see one entry point regardless of whether a
+// conversion is built in or plugged in.
 func Convert(kind string, value float64) (float64, error) {
-	switch kind {
-	case "m2ft":
-		return value * metersToFeetFactor, nil
-	case "ft2m":
-		return value * feetToMetersFactor, nil
-	case "c2f":
-		return value*9.0/5.0 + 32, nil
-	case "f2c":
-		return (value - 32) * 5.0 / 9.0, nil
+	if math.IsNaN(value) || math.IsInf(value, 0) {
+		return 0, errors.New("converter: value must be a finite number")
+	}
+
+	from, to, err := splitKeyword(kind)
+	if err == nil {
+		result, convErr := ConvertUnits(from, to, value)
+		if convErr == nil {
+			return result, nil
+		}
+		err = convErr
+	}
+
+	if c, ok := lookupCustomConverter(kind); ok {
+		return c.Convert(value)
+	}
+	return 0, err
+}
+
+// splitKeyword splits a legacy "from2to" keyword into its two unit
+// names, returning ErrUnsupportedConversion if the keyword is malformed.
+func splitKeyword(kind string) (from, to string, err error) {
+	idx := strings.Index(kind, "2")
+	if idx <= 0 || idx >= len(kind)-1 {
+		return "", "", &ConversionError{Kind: kind, Reason: `malformed keyword, expected "from2to"`, cause: ErrUnsupportedConversion}
+	}
+	return kind[:idx], kind[idx+1:], nil
+}
+
+// ConvertRound is like Convert but rounds the result to decimals decimal
+// places using half-up rounding, e.g. ConvertRound("m2ft", 1, 2) returns
+// 3.28 instead of 3.2808399999999996. decimals must not be negative.
+func ConvertRound(kind string, value float64, decimals int) (float64, error) {
+	if decimals < 0 {
+		return 0, fmt.Errorf("converter: decimals must not be negative, got %d", decimals)
+	}
+	result, err := Convert(kind, value)
+	if err != nil {
+		return 0, err
+	}
+	scale := math.Pow(10, float64(decimals))
+	return math.Round(result*scale) / scale, nil
+}
+
+// RoundingMode selects how ConvertRoundMode rounds a conversion result.
+type RoundingMode int
+
+const (
+	// HalfUp rounds 0.5 away from zero, matching ConvertRound's
+	// existing behavior.
+	HalfUp RoundingMode = iota
+	// HalfEven rounds 0.5 to the nearest even neighbor (banker's
+	// rounding), avoiding the upward bias HalfUp accumulates over many
+	// repeated conversions.
+	HalfEven
+	// Floor always rounds toward negative infinity.
+	Floor
+	// Ceil always rounds toward positive infinity.
+	Ceil
+)
+
+// ConvertRoundMode is like ConvertRound but lets the caller pick the
+// rounding mode instead of always rounding half up. decimals must not
+// be negative.
+func ConvertRoundMode(kind string, value float64, decimals int, mode RoundingMode) (float64, error) {
+	if decimals < 0 {
+		return 0, fmt.Errorf("converter: decimals must not be negative, got %d", decimals)
+	}
+	result, err := Convert(kind, value)
+	if err != nil {
+		return 0, err
+	}
+
+	scale := math.Pow(10, float64(decimals))
+	scaled := result * scale
+	switch mode {
+	case HalfUp:
+		scaled = math.Round(scaled)
+	case HalfEven:
+		scaled = math.RoundToEven(scaled)
+	case Floor:
+		scaled = math.Floor(scaled)
+	case Ceil:
+		scaled = math.Ceil(scaled)
 	default:
-		return 0, errors.New("unsupported conversion")
+		return 0, fmt.Errorf("converter: unknown rounding mode %d", mode)
+	}
+	return scaled / scale, nil
+}
+
+// AlmostEqual reports whether a and b differ by no more than tolerance,
+// which is useful for comparing conversion results without the
+// floating-point noise float64 arithmetic accumulates. NaN is never
+// almost equal to anything, including itself, since "close to NaN"
+// isn't a meaningful comparison. AlmostEqual does no allocation, so
+// it's cheap to call from a tight test loop.
+func AlmostEqual(a, b, tolerance float64) bool {
+	if math.IsNaN(a) || math.IsNaN(b) {
+		return false
+	}
+	return math.Abs(a-b) <= tolerance
+}
+
+// Equal reports whether aValue aUnit and bValue bUnit represent the
+// same amount, within tolerance, e.g. Equal(1, "m", 100, "cm", 1e-9) is
+// true. It converts b into a's unit and compares with AlmostEqual, so
+// it returns a CrossDimensionError if aUnit and bUnit don't share a
+// dimension, or an UnknownUnitError if either isn't registered.
+func Equal(aValue float64, aUnit string, bValue float64, bUnit string, tolerance float64) (bool, error) {
+	converted, err := ConvertUnits(bUnit, aUnit, bValue)
+	if err != nil {
+		return false, err
+	}
+	return AlmostEqual(aValue, converted, tolerance), nil
+}
+
+// ConvertSigFigs is like Convert but rounds the result to sigFigs
+// significant figures rather than decimal places, e.g.
+// ConvertSigFigs("m2mm", 12.345, 3) rounds a result of 12345 down to
+// 12300, and a result of 0.00123456 down to 0.00123. This is what
+// scientific callers usually want instead of ConvertRound's fixed
+// decimal-place rounding. sigFigs must be at least 1.
+func ConvertSigFigs(kind string, value float64, sigFigs int) (float64, error) {
+	if sigFigs < 1 {
+		return 0, fmt.Errorf("converter: sigFigs must be at least 1, got %d", sigFigs)
+	}
+	result, err := Convert(kind, value)
+	if err != nil {
+		return 0, err
+	}
+	return roundToSigFigs(result, sigFigs), nil
+}
+
+// roundToSigFigs rounds v to sigFigs significant figures.
+func roundToSigFigs(v float64, sigFigs int) float64 {
+	if v == 0 {
+		return 0
+	}
+	magnitude := math.Floor(math.Log10(math.Abs(v)))
+	scale := math.Pow(10, float64(sigFigs)-1-magnitude)
+	return math.Round(v*scale) / scale
+}
+
+// Reverse returns the inverse of a legacy "from2to" keyword, e.g.
+// Reverse("m2ft") returns "ft2m". It is useful for building "swap
+// units" controls in a UI. Both unit names must be registered in the
+// package's default registry; otherwise Reverse returns
+// ErrUnsupportedConversion, the same error Convert would have
+// returned for the keyword.
+func Reverse(kind string) (string, error) {
+	from, to, err := splitKeyword(kind)
+	if err != nil {
+		return "", err
+	}
+	if _, ok := CanonicalUnit(from); !ok {
+		return "", fmt.Errorf("%q: %w", kind, ErrUnsupportedConversion)
+	}
+	if _, ok := CanonicalUnit(to); !ok {
+		return "", fmt.Errorf("%q: %w", kind, ErrUnsupportedConversion)
+	}
+	return to + "2" + from, nil
+}
+
+// ConvertUnits converts v from unit name "from" to unit name "to" using
+// the package's default registry. See Registry.ConvertUnits.
+func ConvertUnits(from, to string, v float64) (float64, error) {
+	return defaultRegistry.ConvertUnits(from, to, v)
+}
+
+// ConvertDimension converts v from unit "from" to unit "to" by routing
+// both through their dimension's base unit, so any two units that share
+// a dimension can be converted without a direct keyword ever having
+// been registered for that pair, e.g. ft to in even though only
+// ft<->m and in<->m are registered directly. It produces the same
+// result as ConvertUnits, but memoizes each unit name's resolved
+// registry entry (see Registry.ConvertDimensionCached), which pays off
+// when a caller repeatedly converts the same pair of units, e.g. in a
+// tight loop resolving SI-prefixed units via ParsePrefixed first.
+func ConvertDimension(from, to string, value float64) (float64, error) {
+	return defaultRegistry.ConvertDimensionCached(from, to, value)
+}
+
+// SameDimension reports whether unitA and unitB belong to the same
+// physical dimension in the package's default registry, e.g.
+// SameDimension("kg", "lb") is true but SameDimension("kg", "m") is
+// false. ConvertUnits performs the same check internally to produce
+// its CrossDimensionError. See Registry.SameDimension.
+func SameDimension(unitA, unitB string) (bool, error) {
+	return defaultRegistry.SameDimension(unitA, unitB)
+}
+
+// Register adds a unit to the package's default registry. See
+// Registry.Register.
+func Register(unit Unit, toBase, fromBase func(float64) float64) error {
+	return defaultRegistry.Register(unit, toBase, fromBase)
+}
+
+// Alias registers alternate names for a unit already in the package's
+// default registry. See Registry.Alias.
+func Alias(canonical string, names ...string) error {
+	return defaultRegistry.Alias(canonical, names...)
+}
+
+// CanonicalUnit resolves s to the canonical unit name registered in
+// the package's default registry, following aliases and normalizing
+// case and surrounding whitespace, e.g. CanonicalUnit(" Meters ")
+// returns ("m", true). The second return value is false if s is not a
+// known unit or alias.
+func CanonicalUnit(s string) (string, bool) {
+	return defaultRegistry.Canonical(s)
+}
+
+// Units returns every unit registered in the package's default
+// registry. See Registry.Units.
+func Units() []Unit {
+	return defaultRegistry.Units()
+}
+
+// ListConversions returns every "from2to" keyword that Convert accepts,
+// in sorted order. It is derived from Units so it can never drift out
+// of sync with what Convert actually supports: every pair of distinct
+// units sharing a dimension yields one keyword.
+func ListConversions() []string {
+	units := defaultRegistry.Units()
+	byDimension := make(map[Dimension][]string)
+	for _, unit := range units {
+		byDimension[unit.Dimension] = append(byDimension[unit.Dimension], unit.Name)
+	}
+
+	var keywords []string
+	for _, names := range byDimension {
+		for _, from := range names {
+			for _, to := range names {
+				if from == to {
+					continue
+				}
+				keywords = append(keywords, from+"2"+to)
+			}
+		}
 	}
+	sort.Strings(keywords)
+	return keywords
 }
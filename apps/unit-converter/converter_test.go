@@ -1,6 +1,40 @@
 package converter
 
-import "testing"
+import (
+	"bytes"
+	"compress/gzip"
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"math"
+	"math/rand"
+	"reflect"
+	"sort"
+	"strconv"
+	"strings"
+	"sync"
+	"testing"
+)
+
+// assertClose reports a test failure unless got and want agree within
+// relTol relative tolerance or absTol absolute tolerance, whichever is
+// looser. It falls back to absTol alone when want is zero, where a
+// relative tolerance is meaningless. This replaces the ad-hoc
+// `diff > 1e-5 || diff < -1e-5` checks that hid precision regressions
+// on low-magnitude results, like micrometer conversions.
+func assertClose(t *testing.T, got, want, relTol, absTol float64) {
+	t.Helper()
+	diff := math.Abs(got - want)
+	if diff <= absTol {
+		return
+	}
+	if want != 0 && diff/math.Abs(want) <= relTol {
+		return
+	}
+	t.Fatalf("got %v, want %v (diff %v exceeds relTol=%v, absTol=%v)", got, want, diff, relTol, absTol)
+}
 
 func TestConvertLength(t *testing.T) {
 	tests := []struct {
@@ -19,13 +53,148 @@ func TestConvertLength(t *testing.T) {
 			if err != nil {
 				t.Fatalf("unexpected error: %v", err)
 			}
-			if diff := got - tc.expected; diff > 1e-5 || diff < -1e-5 {
-				t.Fatalf("expected %.5f, got %.5f", tc.expected, got)
+			assertClose(t, got, tc.expected, 1e-5, 1e-5)
+		})
+	}
+}
+
+func TestConvertMass(t *testing.T) {
+	tests := []struct {
+		name     string
+		kind     string
+		value    float64
+		expected float64
+	}{
+		{"kilograms to pounds", "kg2lb", 1, 2.2046226218},
+		{"pounds to kilograms", "lb2kg", 2.2046226218, 1},
+		{"grams to ounces", "g2oz", 28.349523125, 1},
+		{"ounces to grams", "oz2g", 1, 28.349523125},
+		{"kilograms to grams", "kg2g", 1, 1000},
+		{"grams to kilograms", "g2kg", 1000, 1},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			got, err := Convert(tc.kind, tc.value)
+			if err != nil {
+				t.Fatalf("unexpected error: %v", err)
+			}
+			assertClose(t, got, tc.expected, 1e-5, 1e-5)
+		})
+	}
+}
+
+func TestConvertMassRoundTrip(t *testing.T) {
+	const original = 5.0
+	inLb, err := Convert("kg2lb", original)
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	back, err := Convert("lb2kg", inLb)
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	assertClose(t, back, original, 1e-5, 1e-5)
+}
+
+func TestConvertVolume(t *testing.T) {
+	tests := []struct {
+		name     string
+		kind     string
+		value    float64
+		expected float64
+	}{
+		{"liters to US gallons", "l2gal", 3.785411784, 1},
+		{"US gallons to liters", "gal2l", 1, 3.785411784},
+		{"milliliters to US fl oz", "ml2floz", 29.5735295625, 1},
+		{"US fl oz to milliliters", "floz2ml", 1, 29.5735295625},
+		{"liters to milliliters", "l2ml", 1, 1000},
+		{"milliliters to liters", "ml2l", 1000, 1},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			got, err := Convert(tc.kind, tc.value)
+			if err != nil {
+				t.Fatalf("unexpected error: %v", err)
+			}
+			assertClose(t, got, tc.expected, 1e-9, 1e-9)
+		})
+	}
+}
+
+func TestConvertVolumeImperialVsUS(t *testing.T) {
+	tests := []struct {
+		name     string
+		kind     string
+		expected float64
+	}{
+		{"US gallon to liters", "gal2l", 3.785411784},
+		{"imperial gallon to liters", "impgal2l", 4.54609},
+	}
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			got, err := Convert(tc.kind, 1)
+			if err != nil {
+				t.Fatalf("unexpected error: %v", err)
+			}
+			assertClose(t, got, tc.expected, 1e-9, 1e-9)
+		})
+	}
+}
+
+func TestConvertVolumeRoundTrip(t *testing.T) {
+	const original = 5.0
+	inGal, err := Convert("l2gal", original)
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	back, err := Convert("gal2l", inGal)
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	assertClose(t, back, original, 1e-6, 1e-6)
+}
+
+func TestConvertSpeed(t *testing.T) {
+	tests := []struct {
+		name     string
+		kind     string
+		value    float64
+		expected float64
+	}{
+		{"km/h to mph", "kmh2mph", 100, 62.137},
+		{"mph to km/h", "mph2kmh", 62.137, 100},
+		{"m/s to km/h", "ms2kmh", 1, 3.6},
+		{"km/h to m/s", "kmh2ms", 3.6, 1},
+		{"m/s to mph", "ms2mph", 1, 2.23694},
+		{"mph to m/s", "mph2ms", 2.23694, 1},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			got, err := Convert(tc.kind, tc.value)
+			if err != nil {
+				t.Fatalf("unexpected error: %v", err)
 			}
+			assertClose(t, got, tc.expected, 1e-3, 1e-3)
 		})
 	}
 }
 
+func TestConvertSpeedRoundTrip(t *testing.T) {
+	const original = 20.0
+	inKMH, err := Convert("ms2kmh", original)
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	back, err := Convert("kmh2ms", inKMH)
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	assertClose(t, back, original, 1e-5, 1e-5)
+}
+
 func TestConvertTemperature(t *testing.T) {
 	tests := []struct {
 		name     string
@@ -35,6 +204,14 @@ func TestConvertTemperature(t *testing.T) {
 	}{
 		{"celsius to fahrenheit", "c2f", 0, 32},
 		{"fahrenheit to celsius", "f2c", 212, 100},
+		{"celsius to kelvin", "c2k", 0, 273.15},
+		{"kelvin to celsius", "k2c", 273.15, 0},
+		{"fahrenheit to kelvin", "f2k", 32, 273.15},
+		{"kelvin to fahrenheit", "k2f", 273.15, 32},
+		{"celsius to rankine", "c2r", 0, 491.67},
+		{"celsius to reaumur", "c2re", 100, 80},
+		{"celsius to delisle", "c2de", 100, 0},
+		{"celsius to delisle, freezing", "c2de", 0, 150},
 	}
 
 	for _, tc := range tests {
@@ -43,15 +220,1990 @@ func TestConvertTemperature(t *testing.T) {
 			if err != nil {
 				t.Fatalf("unexpected error: %v", err)
 			}
-			if diff := got - tc.expected; diff > 1e-5 || diff < -1e-5 {
-				t.Fatalf("expected %.2f, got %.2f", tc.expected, got)
-			}
+			assertClose(t, got, tc.expected, 1e-5, 1e-5)
 		})
 	}
 }
 
 func TestConvertInvalid(t *testing.T) {
-	if _, err := Convert("invalid", 42); err == nil {
+	_, err := Convert("invalid", 42)
+	if err == nil {
 		t.Fatal("expected error for invalid conversion")
 	}
+	if !errors.Is(err, ErrUnsupportedConversion) {
+		t.Fatalf("expected errors.Is(err, ErrUnsupportedConversion), got %v", err)
+	}
+}
+
+func TestConvertNonFinite(t *testing.T) {
+	tests := []struct {
+		name  string
+		value float64
+	}{
+		{"nan", math.NaN()},
+		{"positive infinity", math.Inf(1)},
+		{"negative infinity", math.Inf(-1)},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			_, err := Convert("m2ft", tc.value)
+			if err == nil {
+				t.Fatalf("expected error for non-finite value %v", tc.value)
+			}
+		})
+	}
+}
+
+func TestAlmostEqual(t *testing.T) {
+	tests := []struct {
+		name      string
+		a, b      float64
+		tolerance float64
+		want      bool
+	}{
+		{"exactly equal", 1.0, 1.0, 0, true},
+		{"within tolerance", 1.0, 1.0000001, 1e-5, true},
+		{"outside tolerance", 1.0, 1.1, 1e-5, false},
+		{"exactly at tolerance", 1.0, 1.5, 0.5, true},
+		{"negative values within tolerance", -1.0, -1.0000001, 1e-5, true},
+		{"a is NaN", math.NaN(), 1.0, 1e9, false},
+		{"b is NaN", 1.0, math.NaN(), 1e9, false},
+		{"both NaN", math.NaN(), math.NaN(), 1e9, false},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			if got := AlmostEqual(tc.a, tc.b, tc.tolerance); got != tc.want {
+				t.Fatalf("AlmostEqual(%v, %v, %v) = %v, want %v", tc.a, tc.b, tc.tolerance, got, tc.want)
+			}
+		})
+	}
+}
+
+func TestConvertFinite(t *testing.T) {
+	got, err := Convert("m2ft", 1)
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	want := metersToFeetFactor
+	if !AlmostEqual(got, want, 1e-9) {
+		t.Fatalf("Convert(\"m2ft\", 1) = %v, want %v", got, want)
+	}
+}
+
+func TestConvertPressure(t *testing.T) {
+	tests := []struct {
+		name     string
+		kind     string
+		value    float64
+		expected float64
+	}{
+		{"pascals to bar", "pa2bar", 1e5, 1},
+		{"bar to pascals", "bar2pa", 1, 1e5},
+		{"atm to pascals", "atm2pa", 1, 101325},
+		{"pascals to atm", "pa2atm", 101325, 1},
+		{"psi to pascals", "psi2pa", 1, 6894.757293},
+		{"pascals to psi", "pa2psi", 6894.757293, 1},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			got, err := Convert(tc.kind, tc.value)
+			if err != nil {
+				t.Fatalf("unexpected error: %v", err)
+			}
+			assertClose(t, got, tc.expected, 1e-9, 1e-9)
+		})
+	}
+}
+
+func TestConvertPressureRoundTrip(t *testing.T) {
+	const original = 2.0
+	inPa, err := Convert("atm2pa", original)
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	back, err := Convert("pa2atm", inPa)
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	assertClose(t, back, original, 1e-6, 1e-6)
+}
+
+func TestConvertEnergy(t *testing.T) {
+	tests := []struct {
+		name     string
+		kind     string
+		value    float64
+		expected float64
+	}{
+		{"joules to calories", "j2cal", 4.184, 1},
+		{"calories to joules", "cal2j", 1, 4.184},
+		{"kWh to joules", "kwh2j", 1, 3.6e6},
+		{"joules to kWh", "j2kwh", 3.6e6, 1},
+		{"kcal to joules", "kcal2j", 1, 4184},
+		{"joules to kcal", "j2kcal", 4184, 1},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			got, err := Convert(tc.kind, tc.value)
+			if err != nil {
+				t.Fatalf("unexpected error: %v", err)
+			}
+			assertClose(t, got, tc.expected, 1e-9, 1e-9)
+		})
+	}
+}
+
+func TestConvertEnergyRoundTrip(t *testing.T) {
+	const original = 100.0
+	inJ, err := Convert("cal2j", original)
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	back, err := Convert("j2cal", inJ)
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	assertClose(t, back, original, 1e-9, 1e-9)
+}
+
+func TestConvertCooking(t *testing.T) {
+	tests := []struct {
+		name     string
+		kind     string
+		value    float64
+		expected float64
+	}{
+		{"cup to mL", "cup2ml", 1, 236.588},
+		{"mL to cup", "ml2cup", 236.588, 1},
+		{"tbsp to mL", "tbsp2ml", 1, 14.7868},
+		{"mL to tbsp", "ml2tbsp", 14.7868, 1},
+		{"tsp to mL", "tsp2ml", 1, 4.92892},
+		{"mL to tsp", "ml2tsp", 4.92892, 1},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			got, err := Convert(tc.kind, tc.value)
+			if err != nil {
+				t.Fatalf("unexpected error: %v", err)
+			}
+			assertClose(t, got, tc.expected, 1e-5, 1e-5)
+		})
+	}
+}
+
+func TestConvertCookingRoundTrip(t *testing.T) {
+	const original = 3.0
+	inML, err := Convert("cup2ml", original)
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	back, err := Convert("ml2cup", inML)
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	assertClose(t, back, original, 1e-9, 1e-9)
+}
+
+func TestReverse(t *testing.T) {
+	tests := []struct {
+		kind string
+		want string
+	}{
+		{"m2ft", "ft2m"},
+		{"c2f", "f2c"},
+		{"kg2lb", "lb2kg"},
+	}
+	for _, tc := range tests {
+		t.Run(tc.kind, func(t *testing.T) {
+			got, err := Reverse(tc.kind)
+			if err != nil {
+				t.Fatalf("unexpected error: %v", err)
+			}
+			if got != tc.want {
+				t.Fatalf("Reverse(%q) = %q, want %q", tc.kind, got, tc.want)
+			}
+		})
+	}
+}
+
+// TestReverseAffineKeyword confirms Reverse needs no special-casing for
+// affine temperature units: it only swaps the keyword's two unit names,
+// and ConvertUnits' toBase/fromBase closures (see registerTemperatureUnit
+// in temperature.go) already invert the affine transform correctly, even
+// for a scale like Delisle that runs in the opposite direction to Kelvin.
+func TestReverseAffineKeyword(t *testing.T) {
+	reversed, err := Reverse("c2de")
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if reversed != "de2c" {
+		t.Fatalf("Reverse(c2de) = %q, want %q", reversed, "de2c")
+	}
+
+	const original = 20.0
+	forward, err := Convert("c2de", original)
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	back, err := Convert(reversed, forward)
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	assertClose(t, back, original, 1e-9, 1e-9)
+}
+
+func TestReverseUnknownKeyword(t *testing.T) {
+	if _, err := Reverse("parsecs2m"); !errors.Is(err, ErrUnsupportedConversion) {
+		t.Fatalf("expected errors.Is(err, ErrUnsupportedConversion), got %v", err)
+	}
+}
+
+func TestConvertFuelEconomy(t *testing.T) {
+	got, err := Convert("mpg2lper100km", 30)
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	assertClose(t, got, 7.84, 1e-2, 1e-2)
+
+	back, err := Convert("lper100km2mpg", got)
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	assertClose(t, back, 30, 1e-5, 1e-5)
+}
+
+func TestConvertFuelEconomyNonPositive(t *testing.T) {
+	tests := []struct {
+		name string
+		kind string
+		v    float64
+	}{
+		{"zero mpg", "mpg2lper100km", 0},
+		{"negative lper100km", "lper100km2mpg", -5},
+	}
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			_, err := Convert(tc.kind, tc.v)
+			if err == nil {
+				t.Fatal("expected error for non-positive value")
+			}
+			if _, ok := err.(*NonPositiveValueError); !ok {
+				t.Fatalf("expected *NonPositiveValueError, got %T", err)
+			}
+		})
+	}
+}
+
+func TestConvertAngle(t *testing.T) {
+	tests := []struct {
+		name     string
+		kind     string
+		value    float64
+		expected float64
+	}{
+		{"degrees to radians", "deg2rad", 180, math.Pi},
+		{"radians to degrees", "rad2deg", math.Pi, 180},
+		{"degrees to gradians", "deg2grad", 90, 100},
+		{"gradians to degrees", "grad2deg", 100, 90},
+		{"radians to gradians", "rad2grad", math.Pi, 200},
+		{"gradians to radians", "grad2rad", 200, math.Pi},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			got, err := Convert(tc.kind, tc.value)
+			if err != nil {
+				t.Fatalf("unexpected error: %v", err)
+			}
+			assertClose(t, got, tc.expected, 1e-9, 1e-9)
+		})
+	}
+}
+
+func TestConvertAngleNoNormalization(t *testing.T) {
+	got, err := Convert("deg2rad", 720)
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	assertClose(t, got, 4*math.Pi, 1e-9, 1e-9)
+}
+
+func TestConvertTime(t *testing.T) {
+	tests := []struct {
+		name     string
+		kind     string
+		value    float64
+		expected float64
+	}{
+		{"seconds to minutes", "s2min", 60, 1},
+		{"minutes to seconds", "min2s", 1, 60},
+		{"minutes to hours", "min2h", 60, 1},
+		{"hours to minutes", "h2min", 1, 60},
+		{"hours to seconds", "h2s", 1, 3600},
+		{"seconds to hours", "s2h", 3600, 1},
+		{"hours to days", "h2day", 24, 1},
+		{"days to hours", "day2h", 1, 24},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			got, err := Convert(tc.kind, tc.value)
+			if err != nil {
+				t.Fatalf("unexpected error: %v", err)
+			}
+			assertClose(t, got, tc.expected, 1e-9, 1e-9)
+		})
+	}
+}
+
+func TestConvertTimeRoundTrip(t *testing.T) {
+	const original = 90.0
+	inHours, err := Convert("min2h", original)
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	back, err := Convert("h2min", inHours)
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	assertClose(t, back, original, 1e-9, 1e-9)
+}
+
+func TestConvertData(t *testing.T) {
+	tests := []struct {
+		name     string
+		kind     string
+		value    float64
+		expected float64
+	}{
+		{"bytes to kilobytes", "b2kb", 1000, 1},
+		{"kilobytes to bytes", "kb2b", 1, 1000},
+		{"megabytes to gigabytes", "mb2gb", 1000, 1},
+		{"gigabytes to megabytes", "gb2mb", 1, 1000},
+		{"kibibytes to mebibytes", "kib2mib", 1024, 1},
+		{"mebibytes to gibibytes", "mib2gib", 1024, 1},
+		{"megabytes to mebibytes", "mb2mib", 1048576.0 / 1e6, 1},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			got, err := Convert(tc.kind, tc.value)
+			if err != nil {
+				t.Fatalf("unexpected error: %v", err)
+			}
+			assertClose(t, got, tc.expected, 1e-9, 1e-9)
+		})
+	}
+}
+
+func TestConvertDimension(t *testing.T) {
+	tests := []struct {
+		name     string
+		from, to string
+		value    float64
+		expected float64
+	}{
+		{"feet to inches", "ft", "in", 1, 12},
+		{"inches to feet", "in", "ft", 12, 1},
+	}
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			got, err := ConvertDimension(tc.from, tc.to, tc.value)
+			if err != nil {
+				t.Fatalf("unexpected error: %v", err)
+			}
+			assertClose(t, got, tc.expected, 1e-5, 1e-5)
+		})
+	}
+}
+
+func TestConvertDimensionCrossDimensionRejection(t *testing.T) {
+	_, err := ConvertDimension("ft", "kg", 1)
+	if err == nil {
+		t.Fatal("expected error converting feet to kilograms")
+	}
+	if _, ok := err.(*CrossDimensionError); !ok {
+		t.Fatalf("expected *CrossDimensionError, got %T", err)
+	}
+}
+
+func TestCanonicalUnit(t *testing.T) {
+	tests := []struct {
+		input string
+		want  string
+	}{
+		{"Meters", "m"},
+		{" metre ", "m"},
+		{"m", "m"},
+		{"KILOGRAMS", "kg"},
+		{"celsius", "c"},
+	}
+	for _, tc := range tests {
+		t.Run(tc.input, func(t *testing.T) {
+			got, ok := CanonicalUnit(tc.input)
+			if !ok {
+				t.Fatalf("CanonicalUnit(%q) reported not found", tc.input)
+			}
+			if got != tc.want {
+				t.Fatalf("CanonicalUnit(%q) = %q, want %q", tc.input, got, tc.want)
+			}
+		})
+	}
+}
+
+func TestCanonicalUnitUnknown(t *testing.T) {
+	if _, ok := CanonicalUnit("parsecs"); ok {
+		t.Fatal("expected CanonicalUnit to report not found for an unknown unit")
+	}
+}
+
+func TestConvertJSON(t *testing.T) {
+	data, err := ConvertJSON("m2ft", 1)
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	var got struct {
+		Kind     string  `json:"kind"`
+		Input    float64 `json:"input"`
+		Output   float64 `json:"output"`
+		FromUnit string  `json:"fromUnit"`
+		ToUnit   string  `json:"toUnit"`
+	}
+	if err := json.Unmarshal(data, &got); err != nil {
+		t.Fatalf("unexpected error unmarshalling: %v", err)
+	}
+
+	if got.Kind != "m2ft" {
+		t.Errorf("got Kind=%q, want m2ft", got.Kind)
+	}
+	if got.Input != 1 {
+		t.Errorf("got Input=%v, want 1", got.Input)
+	}
+	assertClose(t, got.Output, 3.28084, 1e-5, 1e-5)
+	if got.FromUnit != "m" {
+		t.Errorf("got FromUnit=%q, want m", got.FromUnit)
+	}
+	if got.ToUnit != "ft" {
+		t.Errorf("got ToUnit=%q, want ft", got.ToUnit)
+	}
+}
+
+func TestConvertJSONError(t *testing.T) {
+	if _, err := ConvertJSON("bogus", 1); err == nil {
+		t.Fatal("expected error for invalid conversion")
+	}
+}
+
+func TestParseAndConvert(t *testing.T) {
+	tests := []struct {
+		name     string
+		expr     string
+		expected float64
+	}{
+		{"meters to feet", "10 m to ft", 32.8084},
+		{"celsius to fahrenheit", "0 c to f", 32},
+		{"extra whitespace", "  10   m   to   ft  ", 32.8084},
+		{"case insensitive with in", "10 M IN Ft", 32.8084},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			got, err := ParseAndConvert(tc.expr)
+			if err != nil {
+				t.Fatalf("unexpected error: %v", err)
+			}
+			assertClose(t, got, tc.expected, 1e-5, 1e-5)
+		})
+	}
+}
+
+func TestParseAndConvertMalformed(t *testing.T) {
+	tests := []struct {
+		name string
+		expr string
+	}{
+		{"non-numeric value", "ten meters to ft"},
+		{"unknown unit", "10 parsecs to ft"},
+		{"missing connector", "10 m ft"},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			if _, err := ParseAndConvert(tc.expr); err == nil {
+				t.Fatalf("expected error for %q", tc.expr)
+			}
+		})
+	}
+}
+
+func TestConvertMixed(t *testing.T) {
+	got, err := ConvertMixed("3 ft + 5 in to cm")
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	assertClose(t, got, 104.14, 1e-4, 1e-2)
+}
+
+func TestConvertMixedSingleTerm(t *testing.T) {
+	got, err := ConvertMixed("10 m to ft")
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	assertClose(t, got, 32.8084, 1e-5, 1e-5)
+}
+
+func TestConvertMixedDimensionMismatch(t *testing.T) {
+	var target *CrossDimensionError
+	if _, err := ConvertMixed("3 ft + 2 kg to cm"); !errors.As(err, &target) {
+		t.Fatalf("expected *CrossDimensionError, got %v", err)
+	}
+}
+
+func TestConvertMixedMalformed(t *testing.T) {
+	tests := []struct {
+		name string
+		expr string
+	}{
+		{"missing connector", "3 ft 5 in cm"},
+		{"dangling plus", "3 ft + to cm"},
+		{"too short", "3 ft"},
+	}
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			if _, err := ConvertMixed(tc.expr); err == nil {
+				t.Fatalf("expected error for %q", tc.expr)
+			}
+		})
+	}
+}
+
+func TestConvertTempString(t *testing.T) {
+	tests := []struct {
+		name     string
+		input    string
+		target   string
+		expected float64
+	}{
+		{"fahrenheit to celsius", "98.6F", "c", 37},
+		{"celsius to fahrenheit", "37C", "f", 98.6},
+		{"kelvin to celsius", "310K", "c", 36.85},
+		{"lowercase suffix", "37c", "f", 98.6},
+		{"leading and trailing whitespace", "  37C  ", "f", 98.6},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			got, err := ConvertTempString(tc.input, tc.target)
+			if err != nil {
+				t.Fatalf("unexpected error: %v", err)
+			}
+			assertClose(t, got, tc.expected, 1e-2, 1e-2)
+		})
+	}
+}
+
+func TestConvertTempStringMissingSuffix(t *testing.T) {
+	if _, err := ConvertTempString("98.6", "c"); err == nil {
+		t.Fatal("expected error for missing unit suffix")
+	}
+}
+
+func TestConvertTempStringUnknownSuffix(t *testing.T) {
+	_, err := ConvertTempString("98.6Z", "c")
+	if err == nil {
+		t.Fatal("expected error for unknown unit suffix")
+	}
+	if _, ok := err.(*UnknownUnitError); !ok {
+		t.Fatalf("expected *UnknownUnitError, got %T", err)
+	}
+}
+
+// fakeShoeConverter is a minimal Converter used to test RegisterConverter
+// and Convert's fallback to custom converters.
+type fakeShoeConverter struct{}
+
+func (fakeShoeConverter) Convert(value float64) (float64, error) {
+	return value + 1, nil
+}
+
+func (fakeShoeConverter) Units() (from, to string) {
+	return "ussize", "eusize"
+}
+
+func TestRegisterConverterFallback(t *testing.T) {
+	if err := RegisterConverter("ussize2eusize", fakeShoeConverter{}); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	got, err := Convert("ussize2eusize", 9)
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if got != 10 {
+		t.Fatalf("Convert(\"ussize2eusize\", 9) = %v, want 10", got)
+	}
+}
+
+func TestRegisterConverterDuplicate(t *testing.T) {
+	if err := RegisterConverter("ussize2ukssize", fakeShoeConverter{}); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	err := RegisterConverter("ussize2ukssize", fakeShoeConverter{})
+	if err == nil {
+		t.Fatal("expected error for duplicate keyword")
+	}
+	if _, ok := err.(*DuplicateConverterError); !ok {
+		t.Fatalf("expected *DuplicateConverterError, got %T", err)
+	}
+}
+
+func TestConvertPrefersBuiltinOverCustomConverter(t *testing.T) {
+	// A custom Converter registered for an existing built-in keyword
+	// must never be consulted, since Convert always tries the default
+	// Registry first.
+	if err := RegisterConverter("m2ft", fakeShoeConverter{}); err != nil {
+		t.Fatalf("unexpected error registering custom converter: %v", err)
+	}
+
+	got, err := Convert("m2ft", 1)
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if !AlmostEqual(got, metersToFeetFactor, 1e-9) {
+		t.Fatalf("Convert(\"m2ft\", 1) = %v, want built-in result %v", got, metersToFeetFactor)
+	}
+}
+
+func TestConvertWithRecorder(t *testing.T) {
+	rec := &SliceRecorder{}
+
+	got, err := ConvertWithRecorder("m2ft", 1, rec)
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	assertClose(t, got, metersToFeetFactor, 1e-9, 1e-9)
+
+	if len(rec.Entries) != 1 {
+		t.Fatalf("expected 1 recorded entry, got %d", len(rec.Entries))
+	}
+	entry := rec.Entries[0]
+	if entry.Kind != "m2ft" || entry.In != 1 {
+		t.Fatalf("unexpected entry: %+v", entry)
+	}
+	assertClose(t, entry.Out, metersToFeetFactor, 1e-9, 1e-9)
+}
+
+func TestConvertWithRecorderSkipsFailedConversions(t *testing.T) {
+	rec := &SliceRecorder{}
+
+	if _, err := ConvertWithRecorder("invalid", 1, rec); err == nil {
+		t.Fatal("expected error for invalid conversion")
+	}
+	if len(rec.Entries) != 0 {
+		t.Fatalf("expected no recorded entries, got %d", len(rec.Entries))
+	}
+}
+
+func TestParseFeetInches(t *testing.T) {
+	tests := []struct {
+		name     string
+		input    string
+		expected float64
+	}{
+		{"feet and inches with quotes", `5'11"`, 1.8034},
+		{"feet and inches with space", `5' 11"`, 1.8034},
+		{"feet and inches words", "5ft 11in", 1.8034},
+		{"feet only", "6'", 1.8288},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			got, err := ParseFeetInches(tc.input)
+			if err != nil {
+				t.Fatalf("unexpected error: %v", err)
+			}
+			assertClose(t, got, tc.expected, 1e-4, 1e-4)
+		})
+	}
+}
+
+func TestParseFeetInchesMalformed(t *testing.T) {
+	for _, s := range []string{"five feet", "", "11\"", "5'11'"} {
+		if _, err := ParseFeetInches(s); err == nil {
+			t.Fatalf("ParseFeetInches(%q): expected error", s)
+		}
+	}
+}
+
+func TestSameDimensionTrue(t *testing.T) {
+	same, err := SameDimension("kg", "lb")
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if !same {
+		t.Fatal("expected kg and lb to share a dimension")
+	}
+}
+
+func TestSameDimensionFalse(t *testing.T) {
+	same, err := SameDimension("kg", "m")
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if same {
+		t.Fatal("expected kg and m to not share a dimension")
+	}
+
+	_, convErr := ConvertUnits("kg", "m", 1)
+	if _, ok := convErr.(*CrossDimensionError); !ok {
+		t.Fatalf("expected *CrossDimensionError, got %T", convErr)
+	}
+}
+
+func TestSameDimensionUnknownUnit(t *testing.T) {
+	_, err := SameDimension("kg", "parsecs")
+	if _, ok := err.(*UnknownUnitError); !ok {
+		t.Fatalf("expected *UnknownUnitError, got %T", err)
+	}
+}
+
+func TestTable(t *testing.T) {
+	rows, err := Table("m2ft", 1, 3, 1)
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if len(rows) != 3 {
+		t.Fatalf("expected 3 rows, got %d", len(rows))
+	}
+	for i, row := range rows {
+		wantIn := float64(i + 1)
+		if row[0] != wantIn {
+			t.Fatalf("row %d input = %v, want %v", i, row[0], wantIn)
+		}
+		assertClose(t, row[1], wantIn*metersToFeetFactor, 1e-9, 1e-9)
+	}
+}
+
+func TestTableInvalidStep(t *testing.T) {
+	if _, err := Table("m2ft", 1, 3, 0); err == nil {
+		t.Fatal("expected error for non-positive step")
+	}
+	if _, err := Table("m2ft", 1, 3, -1); err == nil {
+		t.Fatal("expected error for negative step")
+	}
+}
+
+func TestTableStartAfterEnd(t *testing.T) {
+	if _, err := Table("m2ft", 3, 1, 1); err == nil {
+		t.Fatal("expected error when start is greater than end")
+	}
+}
+
+func TestTableCSV(t *testing.T) {
+	got, err := TableCSV("m2ft", 1, 2, 1)
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	want := "m,ft\n1,3.28084\n2,6.56168\n"
+	if got != want {
+		t.Fatalf("got %q, want %q", got, want)
+	}
+}
+
+func TestTableRounded(t *testing.T) {
+	rows, err := TableRounded("m2ft", 1, 3, 1, 2)
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	want := [][2]float64{{1, 3.28}, {2, 6.56}, {3, 9.84}}
+	if !reflect.DeepEqual(rows, want) {
+		t.Fatalf("TableRounded(\"m2ft\", 1, 3, 1, 2) = %v, want %v", rows, want)
+	}
+}
+
+func TestTableRoundedNegativeDecimals(t *testing.T) {
+	if _, err := TableRounded("m2ft", 1, 3, 1, -1); err == nil {
+		t.Fatal("expected error for negative decimals")
+	}
+}
+
+func TestTableRoundedInvalidStep(t *testing.T) {
+	if _, err := TableRounded("m2ft", 1, 3, 0, 2); err == nil {
+		t.Fatal("expected error for non-positive step")
+	}
+}
+
+func TestValidateInputRejectsNegativeMass(t *testing.T) {
+	err := ValidateInput("kg2lb", -1)
+	if err == nil {
+		t.Fatal("expected error for negative mass")
+	}
+	if _, ok := err.(*DomainError); !ok {
+		t.Fatalf("expected *DomainError, got %T", err)
+	}
+}
+
+func TestValidateInputRejectsSubAbsoluteZeroKelvin(t *testing.T) {
+	err := ValidateInput("k2c", -1)
+	if err == nil {
+		t.Fatal("expected error for sub-absolute-zero Kelvin")
+	}
+	if _, ok := err.(*DomainError); !ok {
+		t.Fatalf("expected *DomainError, got %T", err)
+	}
+}
+
+func TestValidateInputAllowsNegativeCelsius(t *testing.T) {
+	if err := ValidateInput("c2f", -40); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+}
+
+func TestValidateInputValid(t *testing.T) {
+	if err := ValidateInput("m2ft", 1); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+}
+
+func TestConvertStrict(t *testing.T) {
+	if _, err := ConvertStrict("kg2lb", -1); err == nil {
+		t.Fatal("expected error for negative mass")
+	}
+
+	got, err := ConvertStrict("m2ft", 1)
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	assertClose(t, got, metersToFeetFactor, 1e-9, 1e-9)
+}
+
+func TestConvertBatch(t *testing.T) {
+	reqs := []BatchRequest{
+		{Kind: "m2ft", Value: 1},
+		{Kind: "c2f", Value: 0},
+		{Kind: "kg2lb", Value: 1},
+	}
+	got, err := ConvertBatch(reqs)
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	want := []float64{3.28084, 32, 2.2046226218}
+	if len(got) != len(want) {
+		t.Fatalf("got %d results, want %d", len(got), len(want))
+	}
+	for i := range want {
+		assertClose(t, got[i], want[i], 1e-5, 1e-5)
+	}
+}
+
+func TestConvertBatchInvalid(t *testing.T) {
+	reqs := []BatchRequest{
+		{Kind: "m2ft", Value: 1},
+		{Kind: "bogus", Value: 1},
+		{Kind: "c2f", Value: 0},
+	}
+	_, err := ConvertBatch(reqs)
+	if err == nil {
+		t.Fatal("expected error for invalid request in batch")
+	}
+}
+
+func TestConvertBatchAll(t *testing.T) {
+	reqs := []BatchRequest{
+		{Kind: "m2ft", Value: 1},
+		{Kind: "bogus", Value: 1},
+		{Kind: "kg2lb", Value: 1},
+	}
+	results, errs := ConvertBatchAll(reqs)
+	if len(results) != len(reqs) || len(errs) != len(reqs) {
+		t.Fatalf("got %d results and %d errs, want %d of each", len(results), len(errs), len(reqs))
+	}
+	if errs[0] != nil {
+		t.Fatalf("unexpected error at index 0: %v", errs[0])
+	}
+	assertClose(t, results[0], 3.28084, 1e-5, 1e-5)
+
+	if errs[1] == nil {
+		t.Fatal("expected error at index 1")
+	}
+	if results[1] != 0 {
+		t.Fatalf("results[1] = %g, want 0 for a failed request", results[1])
+	}
+
+	if errs[2] != nil {
+		t.Fatalf("unexpected error at index 2: %v", errs[2])
+	}
+	assertClose(t, results[2], 2.2046226218, 1e-5, 1e-5)
+}
+
+func TestConvertFormatted(t *testing.T) {
+	tests := []struct {
+		name     string
+		kind     string
+		value    float64
+		expected string
+	}{
+		{"meters to feet", "m2ft", 1, "1 m = 3.28084 ft"},
+		{"celsius to fahrenheit", "c2f", 0, "0 °C = 32 °F"},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			got, err := ConvertFormatted(tc.kind, tc.value)
+			if err != nil {
+				t.Fatalf("unexpected error: %v", err)
+			}
+			if got != tc.expected {
+				t.Fatalf("got %q, want %q", got, tc.expected)
+			}
+		})
+	}
+}
+
+func TestConvertFormattedASCII(t *testing.T) {
+	tests := []struct {
+		name     string
+		kind     string
+		value    float64
+		expected string
+	}{
+		{"celsius to fahrenheit", "c2f", 0, "0 degC = 32 degF"},
+		{"micrometers to meters", "µm2m", 1000000, "1000000 um = 1 m"},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			got, err := ConvertFormattedASCII(tc.kind, tc.value)
+			if err != nil {
+				t.Fatalf("unexpected error: %v", err)
+			}
+			if got != tc.expected {
+				t.Fatalf("got %q, want %q", got, tc.expected)
+			}
+		})
+	}
+}
+
+func TestConvertScientific(t *testing.T) {
+	got, err := ConvertScientific("b2gib", 5e9, 3)
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	want := "4.66e+00"
+	if got != want {
+		t.Fatalf("got %q, want %q", got, want)
+	}
+}
+
+func TestConvertScientificInvalidSigFigs(t *testing.T) {
+	if _, err := ConvertScientific("m2ft", 1, 0); err == nil {
+		t.Fatal("expected error for sigFigs < 1")
+	}
+}
+
+func TestLocalizeNumber(t *testing.T) {
+	tests := []struct {
+		name         string
+		input        string
+		decimalSep   string
+		thousandsSep string
+		want         string
+	}{
+		{"european", "1234.5", ",", ".", "1.234,5"},
+		{"us", "1234.5", ".", ",", "1,234.5"},
+		{"negative", "-1234.5", ",", ".", "-1.234,5"},
+		{"no fraction", "1234", ",", ".", "1.234"},
+		{"small", "3.28", ",", ".", "3,28"},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			got := localizeNumber(tc.input, tc.decimalSep, tc.thousandsSep)
+			if got != tc.want {
+				t.Fatalf("localizeNumber(%q, %q, %q) = %q, want %q", tc.input, tc.decimalSep, tc.thousandsSep, got, tc.want)
+			}
+		})
+	}
+}
+
+func TestConvertFormattedLocale(t *testing.T) {
+	tests := []struct {
+		name         string
+		decimalSep   string
+		thousandsSep string
+		expected     string
+	}{
+		{"european separators", ",", ".", "1.234,5 m = 4.050,19698 ft"},
+		{"us separators", ".", ",", "1,234.5 m = 4,050.19698 ft"},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			got, err := ConvertFormattedLocale("m2ft", 1234.5, tc.decimalSep, tc.thousandsSep)
+			if err != nil {
+				t.Fatalf("unexpected error: %v", err)
+			}
+			if got != tc.expected {
+				t.Fatalf("got %q, want %q", got, tc.expected)
+			}
+		})
+	}
+}
+
+func TestConvertRound(t *testing.T) {
+	got, err := ConvertRound("m2ft", 1, 2)
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if got != 3.28 {
+		t.Fatalf("got %v, want 3.28", got)
+	}
+}
+
+func TestConvertRoundModeHalfEven(t *testing.T) {
+	tests := []struct {
+		name  string
+		value float64
+		want  float64
+	}{
+		{"2.5 rounds down to even", 2.5, 2},
+		{"3.5 rounds up to even", 3.5, 4},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			got, err := ConvertRoundMode("m2m", tc.value, 0, HalfEven)
+			if err != nil {
+				t.Fatalf("unexpected error: %v", err)
+			}
+			if got != tc.want {
+				t.Fatalf("got %v, want %v", got, tc.want)
+			}
+		})
+	}
+}
+
+func TestConvertRoundModeFloorCeil(t *testing.T) {
+	tests := []struct {
+		name  string
+		mode  RoundingMode
+		value float64
+		want  float64
+	}{
+		{"floor positive", Floor, 1.9, 1},
+		{"floor negative", Floor, -1.1, -2},
+		{"ceil positive", Ceil, 1.1, 2},
+		{"ceil negative", Ceil, -1.9, -1},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			got, err := ConvertRoundMode("m2m", tc.value, 0, tc.mode)
+			if err != nil {
+				t.Fatalf("unexpected error: %v", err)
+			}
+			if got != tc.want {
+				t.Fatalf("got %v, want %v", got, tc.want)
+			}
+		})
+	}
+}
+
+func TestConvertRoundModeNegativeDecimals(t *testing.T) {
+	if _, err := ConvertRoundMode("m2ft", 1, -1, HalfUp); err == nil {
+		t.Fatal("expected error for negative decimals")
+	}
+}
+
+func TestConvertRoundNegativeDecimals(t *testing.T) {
+	if _, err := ConvertRound("m2ft", 1, -1); err == nil {
+		t.Fatal("expected error for negative decimals")
+	}
+}
+
+func TestConvertSigFigs(t *testing.T) {
+	tests := []struct {
+		name    string
+		kind    string
+		value   float64
+		sigFigs int
+		want    float64
+	}{
+		{"large magnitude", "m2mm", 12.345, 3, 12300},
+		{"small magnitude", "mm2m", 1.23456, 3, 0.00123},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			got, err := ConvertSigFigs(tc.kind, tc.value, tc.sigFigs)
+			if err != nil {
+				t.Fatalf("unexpected error: %v", err)
+			}
+			assertClose(t, got, tc.want, 1e-12, 1e-12)
+		})
+	}
+}
+
+func TestConvertSigFigsInvalid(t *testing.T) {
+	if _, err := ConvertSigFigs("m2ft", 1, 0); err == nil {
+		t.Fatal("expected error for sigFigs < 1")
+	}
+}
+
+func TestListConversions(t *testing.T) {
+	keywords := ListConversions()
+
+	if !sort.StringsAreSorted(keywords) {
+		t.Fatal("expected ListConversions to return a sorted slice")
+	}
+
+	want := []string{"m2ft", "ft2m", "c2f", "f2c", "kg2lb", "lb2kg"}
+	got := make(map[string]bool, len(keywords))
+	for _, kw := range keywords {
+		got[kw] = true
+	}
+	for _, kw := range want {
+		if !got[kw] {
+			t.Errorf("expected ListConversions to contain %q", kw)
+		}
+	}
+}
+
+func TestConvertUnitsAliasesAndCase(t *testing.T) {
+	tests := []struct {
+		from, to string
+		value    float64
+		expected float64
+	}{
+		{"METERS", "Feet", 1, 3.28084},
+		{"°C", "°F", 0, 32},
+		{"celsius", "fahrenheit", 100, 212},
+	}
+	for _, tc := range tests {
+		got, err := ConvertUnits(tc.from, tc.to, tc.value)
+		if err != nil {
+			t.Fatalf("ConvertUnits(%q, %q, %v): unexpected error: %v", tc.from, tc.to, tc.value, err)
+		}
+		assertClose(t, got, tc.expected, 1e-5, 1e-5)
+	}
+}
+
+func TestConvertSIPrefixes(t *testing.T) {
+	tests := []struct {
+		from, to string
+		value    float64
+		expected float64
+	}{
+		{"km", "m", 1, 1000},
+		{"hm", "m", 10, 1000},
+		{"dam", "m", 100, 1000},
+		{"dm", "m", 10, 1},
+		{"cm", "m", 100, 1},
+		{"mm", "m", 1000, 1},
+		{"nm", "m", 1e9, 1},
+		{"µm", "m", 1e6, 1},
+	}
+	for _, tc := range tests {
+		got, err := ConvertUnits(tc.from, tc.to, tc.value)
+		if err != nil {
+			t.Fatalf("ConvertUnits(%q, %q, %v): unexpected error: %v", tc.from, tc.to, tc.value, err)
+		}
+		assertClose(t, got, tc.expected, 1e-9, 1e-9)
+	}
+}
+
+func TestConvertCrossDimension(t *testing.T) {
+	tests := []struct {
+		name     string
+		from, to string
+	}{
+		{"meters to celsius", "m", "c"},
+		{"meters to grams", "m", "g"},
+	}
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			_, err := ConvertUnits(tc.from, tc.to, 1)
+			if err == nil {
+				t.Fatalf("expected error converting %s to %s", tc.from, tc.to)
+			}
+			if _, ok := err.(*CrossDimensionError); !ok {
+				t.Fatalf("expected *CrossDimensionError, got %T", err)
+			}
+		})
+	}
+}
+
+func TestConvertUnknownUnit(t *testing.T) {
+	_, err := ConvertUnits("parsecs", "m", 1)
+	if err == nil {
+		t.Fatal("expected error for unknown unit")
+	}
+	if _, ok := err.(*UnknownUnitError); !ok {
+		t.Fatalf("expected *UnknownUnitError, got %T", err)
+	}
+}
+
+// TestRegisterThirdPartyUnit verifies that code outside the package can
+// extend the default registry at init time, the extensibility the
+// switch-based Convert didn't offer.
+func TestRegisterThirdPartyUnit(t *testing.T) {
+	const smootToMeters = 1.7018
+	unit := Unit{Name: "smoot_test", Dimension: DimensionLength}
+	if err := Register(unit,
+		func(v float64) float64 { return v * smootToMeters },
+		func(v float64) float64 { return v / smootToMeters },
+	); err != nil {
+		t.Fatalf("unexpected error registering third-party unit: %v", err)
+	}
+
+	got, err := ConvertUnits("smoot_test", "m", 1)
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	assertClose(t, got, smootToMeters, 1e-9, 1e-9)
+
+	if err := Register(unit, nil, nil); err == nil {
+		t.Fatal("expected error re-registering a duplicate unit name")
+	}
+}
+
+func TestConvertBelowAbsoluteZero(t *testing.T) {
+	tests := []struct {
+		name string
+		from string
+		to   string
+		v    float64
+	}{
+		{"celsius below absolute zero", "c", "f", -300},
+		{"fahrenheit below absolute zero", "f", "c", -500},
+		{"kelvin below absolute zero", "k", "c", -1},
+		{"delisle below absolute zero (above its own max)", "de", "c", 600},
+	}
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			_, err := ConvertUnits(tc.from, tc.to, tc.v)
+			if err == nil {
+				t.Fatal("expected error for value below absolute zero")
+			}
+			if _, ok := err.(*ErrBelowAbsoluteZero); !ok {
+				t.Fatalf("expected *ErrBelowAbsoluteZero, got %T", err)
+			}
+		})
+	}
+}
+
+// TestConvertRoundTrip checks that converting a unit to every other
+// unit in its dimension and back recovers the original value, across a
+// range of boundary values (zero, negative, very large, very small).
+func TestConvertRoundTrip(t *testing.T) {
+	dimensions := map[Dimension][]string{
+		DimensionLength:      {"m", "ft", "km", "cm", "mm"},
+		DimensionTemperature: {"c", "f", "k", "r", "re", "de"},
+	}
+	boundaryValues := []float64{0, 1, -1, 1e6, 1e-6}
+
+	for dim, units := range dimensions {
+		base := "m"
+		if dim == DimensionTemperature {
+			base = "k"
+		}
+		for _, unit := range units {
+			for _, v := range boundaryValues {
+				name := string(dim) + "/" + unit
+				t.Run(name, func(t *testing.T) {
+					inBase, err := ConvertUnits(unit, base, v)
+					if err != nil {
+						// A boundary value may be below this unit's
+						// absolute zero; that's the validation working
+						// as intended, not a round-trip failure.
+						return
+					}
+					back, err := ConvertUnits(base, unit, inBase)
+					if err != nil {
+						t.Fatalf("unexpected error converting back: %v", err)
+					}
+					assertClose(t, back, v, 1e-6, 1e-6)
+				})
+			}
+		}
+	}
+}
+
+func TestConvertExpr(t *testing.T) {
+	tests := []struct {
+		name     string
+		expr     string
+		value    float64
+		expected float64
+	}{
+		{"km/h to m/s", "km/h -> m/s", 36, 10},
+		{"N*m to J", "N*m -> J", 2, 2},
+		{"kg*m^2/s^2 to J", "kg*m^2/s^2 -> J", 3, 3},
+	}
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			got, _, err := ConvertExpr(tc.expr, tc.value)
+			if err != nil {
+				t.Fatalf("unexpected error: %v", err)
+			}
+			assertClose(t, got, tc.expected, 1e-9, 1e-9)
+		})
+	}
+}
+
+func TestConvertExprDimensionMismatch(t *testing.T) {
+	_, _, err := ConvertExpr("m -> s", 1)
+	if err == nil {
+		t.Fatal("expected error converting length to time")
+	}
+	if _, ok := err.(*DimensionMismatchError); !ok {
+		t.Fatalf("expected *DimensionMismatchError, got %T", err)
+	}
+}
+
+// TestConvertConcurrentRegistration exercises Convert from many
+// goroutines while another goroutine registers a brand-new unit, to
+// catch data races in the Registry under `go test -race`. It does not
+// assert that the new unit is visible to every in-flight Convert call,
+// only that no call ever errors unexpectedly or panics.
+func TestConvertConcurrentRegistration(t *testing.T) {
+	var wg sync.WaitGroup
+
+	stop := make(chan struct{})
+	for i := 0; i < 8; i++ {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			for {
+				select {
+				case <-stop:
+					return
+				default:
+					if _, err := Convert("m2ft", 1); err != nil {
+						t.Errorf("unexpected error: %v", err)
+						return
+					}
+				}
+			}
+		}()
+	}
+
+	for i := 0; i < 50; i++ {
+		name := fmt.Sprintf("race_test_unit_%d", i)
+		unit := Unit{Name: name, Dimension: DimensionLength}
+		if err := Register(unit,
+			func(v float64) float64 { return v },
+			func(v float64) float64 { return v },
+		); err != nil {
+			t.Fatalf("unexpected error registering %q: %v", name, err)
+		}
+	}
+
+	close(stop)
+	wg.Wait()
+}
+
+func TestDescribe(t *testing.T) {
+	info, err := Describe("m2ft")
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	want := Info{Keyword: "m2ft", FromUnit: "m", ToUnit: "ft", Dimension: "length"}
+	if info != want {
+		t.Fatalf("Describe(\"m2ft\") = %+v, want %+v", info, want)
+	}
+}
+
+func TestDescribeUnknownUnit(t *testing.T) {
+	if _, err := Describe("bogus2ft"); err == nil {
+		t.Fatal("expected error for unknown unit")
+	}
+}
+
+func TestDescribeCrossDimension(t *testing.T) {
+	if _, err := Describe("m2c"); err == nil {
+		t.Fatal("expected error for cross-dimension keyword")
+	}
+}
+
+func TestByDimension(t *testing.T) {
+	infos := ByDimension("length")
+	if len(infos) == 0 {
+		t.Fatal("expected at least one length conversion")
+	}
+	for _, info := range infos {
+		if info.Dimension != "length" {
+			t.Fatalf("ByDimension(\"length\") returned non-length Info: %+v", info)
+		}
+		if info.Keyword != info.FromUnit+"2"+info.ToUnit {
+			t.Fatalf("Info keyword %q does not match FromUnit/ToUnit %q/%q", info.Keyword, info.FromUnit, info.ToUnit)
+		}
+	}
+
+	var foundM2Ft bool
+	for _, info := range infos {
+		if info.Keyword == "m2ft" {
+			foundM2Ft = true
+			break
+		}
+	}
+	if !foundM2Ft {
+		t.Fatal(`ByDimension("length") missing expected keyword "m2ft"`)
+	}
+}
+
+func TestByDimensionUnknown(t *testing.T) {
+	if infos := ByDimension("not-a-dimension"); len(infos) != 0 {
+		t.Fatalf("ByDimension(\"not-a-dimension\") = %v, want empty", infos)
+	}
+}
+
+func TestRandomValidReproducible(t *testing.T) {
+	value1, result1, err := RandomValid("m2ft", rand.New(rand.NewSource(42)))
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	value2, result2, err := RandomValid("m2ft", rand.New(rand.NewSource(42)))
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if value1 != value2 || result1 != result2 {
+		t.Fatalf("RandomValid with the same seed produced different output: (%v, %v) vs (%v, %v)", value1, result1, value2, result2)
+	}
+}
+
+func TestRandomValidNonNegativeDimension(t *testing.T) {
+	rng := rand.New(rand.NewSource(1))
+	for i := 0; i < 100; i++ {
+		value, _, err := RandomValid("kg2lb", rng)
+		if err != nil {
+			t.Fatalf("unexpected error: %v", err)
+		}
+		if value < 0 {
+			t.Fatalf("RandomValid(\"kg2lb\", ...) generated a negative mass: %v", value)
+		}
+	}
+}
+
+func TestRandomValidTemperatureRespectsAbsoluteZero(t *testing.T) {
+	rng := rand.New(rand.NewSource(2))
+	for i := 0; i < 100; i++ {
+		value, _, err := RandomValid("c2f", rng)
+		if err != nil {
+			t.Fatalf("unexpected error: %v", err)
+		}
+		if value < -273.15 {
+			t.Fatalf("RandomValid(\"c2f\", ...) generated a Celsius value below absolute zero: %v", value)
+		}
+	}
+}
+
+func TestRandomValidUnknownUnit(t *testing.T) {
+	if _, _, err := RandomValid("bogus2ft", rand.New(rand.NewSource(1))); err == nil {
+		t.Fatal("expected error for unknown unit")
+	}
+}
+
+func TestConvertStream(t *testing.T) {
+	input := "1\n2\n3\n"
+	var out bytes.Buffer
+	if err := ConvertStream("m2ft", strings.NewReader(input), &out); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	lines := strings.Split(strings.TrimRight(out.String(), "\n"), "\n")
+	if len(lines) != 3 {
+		t.Fatalf("got %d output lines, want 3: %q", len(lines), out.String())
+	}
+	for i, line := range lines {
+		got, err := strconv.ParseFloat(line, 64)
+		if err != nil {
+			t.Fatalf("output line %d %q did not parse as a float: %v", i+1, line, err)
+		}
+		want := float64(i+1) * metersToFeetFactor
+		assertClose(t, got, want, 1e-9, 1e-9)
+	}
+}
+
+func TestConvertStreamSkipsBlankLines(t *testing.T) {
+	input := "1\n\n2\n"
+	var out bytes.Buffer
+	if err := ConvertStream("m2ft", strings.NewReader(input), &out); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	lines := strings.Split(strings.TrimRight(out.String(), "\n"), "\n")
+	if len(lines) != 2 {
+		t.Fatalf("got %d output lines, want 2: %q", len(lines), out.String())
+	}
+}
+
+func TestConvertStreamMalformedLine(t *testing.T) {
+	input := "1\n2\nnot-a-number\n4\n"
+	var out bytes.Buffer
+	err := ConvertStream("m2ft", strings.NewReader(input), &out)
+	if err == nil {
+		t.Fatal("expected error for malformed line")
+	}
+	if !strings.Contains(err.Error(), "line 3") {
+		t.Fatalf("expected error to mention line 3, got: %v", err)
+	}
+}
+
+func TestConvertStreamGzip(t *testing.T) {
+	var compressedIn bytes.Buffer
+	gzipWriter := gzip.NewWriter(&compressedIn)
+	fmt.Fprint(gzipWriter, "1\n2\n3\n")
+	if err := gzipWriter.Close(); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	var compressedOut bytes.Buffer
+	if err := ConvertStreamGzip("m2ft", &compressedIn, &compressedOut); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	gzipReader, err := gzip.NewReader(&compressedOut)
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	defer gzipReader.Close()
+
+	out, err := io.ReadAll(gzipReader)
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	lines := strings.Split(strings.TrimRight(string(out), "\n"), "\n")
+	if len(lines) != 3 {
+		t.Fatalf("got %d output lines, want 3: %q", len(lines), out)
+	}
+	for i, line := range lines {
+		got, err := strconv.ParseFloat(line, 64)
+		if err != nil {
+			t.Fatalf("output line %d %q did not parse as a float: %v", i+1, line, err)
+		}
+		want := float64(i+1) * metersToFeetFactor
+		assertClose(t, got, want, 1e-9, 1e-9)
+	}
+}
+
+func TestConvertStreamGzipCorruptInput(t *testing.T) {
+	if err := ConvertStreamGzip("m2ft", strings.NewReader("not a gzip stream"), &bytes.Buffer{}); err == nil {
+		t.Fatal("expected error for corrupt gzip input")
+	}
+}
+
+func TestConvertCompound(t *testing.T) {
+	// 1 N*m is about 0.73756 lbf*ft.
+	got, err := ConvertCompound([]string{"n", "m"}, []string{"lbf", "ft"}, 1)
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	assertClose(t, got, 0.737562, 1e-5, 1e-5)
+}
+
+func TestConvertCompoundLengthMismatch(t *testing.T) {
+	var target *CompoundLengthMismatchError
+	_, err := ConvertCompound([]string{"n", "m"}, []string{"lbf"}, 1)
+	if !errors.As(err, &target) {
+		t.Fatalf("expected *CompoundLengthMismatchError, got %v", err)
+	}
+}
+
+func TestConvertCompoundDimensionMismatch(t *testing.T) {
+	var target *CrossDimensionError
+	_, err := ConvertCompound([]string{"n", "m"}, []string{"m", "lbf"}, 1)
+	if !errors.As(err, &target) {
+		t.Fatalf("expected *CrossDimensionError, got %v", err)
+	}
+}
+
+func TestConvertCompoundUnknownUnit(t *testing.T) {
+	var target *UnknownUnitError
+	_, err := ConvertCompound([]string{"n", "bogus"}, []string{"lbf", "ft"}, 1)
+	if !errors.As(err, &target) {
+		t.Fatalf("expected *UnknownUnitError, got %v", err)
+	}
+}
+
+func TestHumanizeLength(t *testing.T) {
+	tests := []struct {
+		baseValue float64
+		want      string
+	}{
+		{0.001, "1 mm"},
+		{0.5, "500 mm"},
+		{1, "1 m"},
+		{1500, "1.5 km"},
+	}
+	for _, tc := range tests {
+		got, err := Humanize("length", tc.baseValue)
+		if err != nil {
+			t.Fatalf("unexpected error: %v", err)
+		}
+		if got != tc.want {
+			t.Errorf("Humanize(length, %v) = %q, want %q", tc.baseValue, got, tc.want)
+		}
+	}
+}
+
+func TestHumanizeMass(t *testing.T) {
+	tests := []struct {
+		baseValue float64
+		want      string
+	}{
+		{2.5, "2.5 kg"},
+		{0.0005, "500 mg"},
+		{0.5, "500 g"},
+		{2500, "2.5 t"},
+	}
+	for _, tc := range tests {
+		got, err := Humanize("mass", tc.baseValue)
+		if err != nil {
+			t.Fatalf("unexpected error: %v", err)
+		}
+		if got != tc.want {
+			t.Errorf("Humanize(mass, %v) = %q, want %q", tc.baseValue, got, tc.want)
+		}
+	}
+}
+
+func TestHumanizeUnsupportedDimension(t *testing.T) {
+	if _, err := Humanize("time", 10); err == nil {
+		t.Fatal("expected error for unsupported dimension")
+	}
+}
+
+// cancelAfterReader cancels its context after its first successful Read,
+// simulating a cancellation that arrives partway through a stream.
+type cancelAfterReader struct {
+	r      io.Reader
+	cancel context.CancelFunc
+	used   bool
+}
+
+func (c *cancelAfterReader) Read(p []byte) (int, error) {
+	n, err := c.r.Read(p)
+	if !c.used {
+		c.used = true
+		c.cancel()
+	}
+	return n, err
+}
+
+func TestConvertStreamContextCancelled(t *testing.T) {
+	ctx, cancel := context.WithCancel(context.Background())
+	r := &cancelAfterReader{r: strings.NewReader("1\n2\n3\n4\n5\n"), cancel: cancel}
+
+	var out bytes.Buffer
+	err := ConvertStreamContext(ctx, "m2ft", r, &out)
+	if !errors.Is(err, context.Canceled) {
+		t.Fatalf("expected context.Canceled, got %v", err)
+	}
+
+	lines := strings.Split(strings.TrimRight(out.String(), "\n"), "\n")
+	if len(lines) >= 5 {
+		t.Fatalf("expected cancellation to stop before the whole stream was processed, got %d lines", len(lines))
+	}
+}
+
+func TestConvertStreamContextCompletes(t *testing.T) {
+	var out bytes.Buffer
+	if err := ConvertStreamContext(context.Background(), "m2ft", strings.NewReader("1\n2\n"), &out); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	lines := strings.Split(strings.TrimRight(out.String(), "\n"), "\n")
+	if len(lines) != 2 {
+		t.Fatalf("got %d output lines, want 2: %q", len(lines), out.String())
+	}
+}
+
+func TestConvertChain(t *testing.T) {
+	results, err := ConvertChain([]string{"m2ft", "ft2in"}, 1)
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if len(results) != 3 {
+		t.Fatalf("got %d results, want 3: %v", len(results), results)
+	}
+	assertClose(t, results[0], 1, 1e-9, 1e-9)
+	assertClose(t, results[1], metersToFeetFactor, 1e-9, 1e-9)
+	assertClose(t, results[2], metersToFeetFactor*12, 1e-6, 1e-6)
+}
+
+func TestConvertChainStepError(t *testing.T) {
+	_, err := ConvertChain([]string{"m2ft", "bogus", "ft2in"}, 1)
+	if err == nil {
+		t.Fatal("expected an error")
+	}
+	if !strings.Contains(err.Error(), "step 1") {
+		t.Fatalf("expected error to mention step 1, got: %v", err)
+	}
+}
+
+func TestConversionErrorUnsupportedKeyword(t *testing.T) {
+	_, err := Convert("notakeyword", 1)
+	if !errors.Is(err, ErrUnsupportedConversion) {
+		t.Fatalf("expected errors.Is(err, ErrUnsupportedConversion), got %v", err)
+	}
+	var target *ConversionError
+	if !errors.As(err, &target) {
+		t.Fatalf("expected *ConversionError, got %T", err)
+	}
+	if target.Kind != "notakeyword" {
+		t.Fatalf("Kind = %q, want %q", target.Kind, "notakeyword")
+	}
+}
+
+func TestConversionErrorValidation(t *testing.T) {
+	_, err := ConvertStrict("kg2lb", -1)
+	var target *ConversionError
+	if !errors.As(err, &target) {
+		t.Fatalf("expected *ConversionError, got %T", err)
+	}
+	if target.Kind != "kg2lb" {
+		t.Fatalf("Kind = %q, want %q", target.Kind, "kg2lb")
+	}
+	if target.Reason == "" {
+		t.Fatal("expected a non-empty Reason")
+	}
+}
+
+func TestQuantityTo(t *testing.T) {
+	q := Quantity{Value: 1, Unit: "m"}
+	got, err := q.To("ft")
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	assertClose(t, got.Value, metersToFeetFactor, 1e-9, 1e-9)
+	if got.Unit != "ft" {
+		t.Fatalf("Unit = %q, want %q", got.Unit, "ft")
+	}
+}
+
+func TestQuantityToIncompatibleUnit(t *testing.T) {
+	q := Quantity{Value: 1, Unit: "m"}
+	var target *CrossDimensionError
+	if _, err := q.To("kg"); !errors.As(err, &target) {
+		t.Fatalf("expected *CrossDimensionError, got %v", err)
+	}
+}
+
+func TestQuantityString(t *testing.T) {
+	q := Quantity{Value: 1, Unit: "m"}
+	if got, want := q.String(), "1 m"; got != want {
+		t.Fatalf("String() = %q, want %q", got, want)
+	}
+}
+
+func TestConvertToAll(t *testing.T) {
+	results, err := ConvertToAll("m", 1)
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	ft, ok := results["ft"]
+	if !ok {
+		t.Fatal(`expected "ft" in results`)
+	}
+	assertClose(t, ft, metersToFeetFactor, 1e-5, 1e-5)
+
+	cm, ok := results["cm"]
+	if !ok {
+		t.Fatal(`expected "cm" in results`)
+	}
+	assertClose(t, cm, 100, 1e-9, 1e-9)
+}
+
+func TestConvertToAllUnknownUnit(t *testing.T) {
+	var target *UnknownUnitError
+	if _, err := ConvertToAll("bogus", 1); !errors.As(err, &target) {
+		t.Fatalf("expected *UnknownUnitError, got %v", err)
+	}
+}
+
+func TestParsePrefixed(t *testing.T) {
+	tests := []struct {
+		unit       string
+		baseUnit   string
+		wantFactor float64
+	}{
+		{"km", "m", 1000},
+		{"cm", "m", 0.01},
+		{"mg", "g", 0.001},
+		{"m", "m", 1},
+	}
+	for _, tc := range tests {
+		base, factor, err := ParsePrefixed(tc.unit)
+		if err != nil {
+			t.Fatalf("ParsePrefixed(%q) returned unexpected error: %v", tc.unit, err)
+		}
+		if base != tc.baseUnit {
+			t.Errorf("ParsePrefixed(%q) base = %q, want %q", tc.unit, base, tc.baseUnit)
+		}
+		assertClose(t, factor, tc.wantFactor, 1e-9, 1e-9)
+	}
+}
+
+func TestParsePrefixedUnknownUnit(t *testing.T) {
+	var target *UnknownUnitError
+	if _, _, err := ParsePrefixed("bogus"); !errors.As(err, &target) {
+		t.Fatalf("expected *UnknownUnitError, got %v", err)
+	}
+}
+
+func TestKeywordFor(t *testing.T) {
+	tests := []struct {
+		from, to string
+		want     string
+	}{
+		{"m", "ft", "m2ft"},
+		{"ft", "m", "ft2m"},
+		{"kg", "lb", "kg2lb"},
+	}
+	for _, tc := range tests {
+		got, err := KeywordFor(tc.from, tc.to)
+		if err != nil {
+			t.Fatalf("KeywordFor(%q, %q) returned unexpected error: %v", tc.from, tc.to, err)
+		}
+		if got != tc.want {
+			t.Errorf("KeywordFor(%q, %q) = %q, want %q", tc.from, tc.to, got, tc.want)
+		}
+	}
+}
+
+func TestEqual(t *testing.T) {
+	equal, err := Equal(1, "m", 100, "cm", 1e-9)
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if !equal {
+		t.Fatal("expected 1 m to equal 100 cm")
+	}
+}
+
+func TestEqualNotEqual(t *testing.T) {
+	equal, err := Equal(1, "m", 3, "ft", 1e-6)
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if equal {
+		t.Fatal("expected 1 m and 3 ft to not be equal within a tight tolerance")
+	}
+}
+
+func TestEqualCrossDimension(t *testing.T) {
+	var target *CrossDimensionError
+	if _, err := Equal(1, "m", 1, "kg", 1e-9); !errors.As(err, &target) {
+		t.Fatalf("expected *CrossDimensionError, got %v", err)
+	}
+}
+
+func TestConvertUnitsOverflow(t *testing.T) {
+	r := NewRegistry()
+	if err := r.Register(Unit{Name: "base", Dimension: "test-dim"},
+		func(v float64) float64 { return v },
+		func(v float64) float64 { return v }); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if err := r.Register(Unit{Name: "huge", Dimension: "test-dim"},
+		func(v float64) float64 { return v / 1e300 },
+		func(v float64) float64 { return v * 1e300 }); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	var target *OverflowError
+	if _, err := r.ConvertUnits("base", "huge", 1e300); !errors.As(err, &target) {
+		t.Fatalf("expected *OverflowError, got %v", err)
+	}
+}
+
+func TestConvertUnitsUnderflow(t *testing.T) {
+	r := NewRegistry()
+	if err := r.Register(Unit{Name: "base", Dimension: "test-dim"},
+		func(v float64) float64 { return v },
+		func(v float64) float64 { return v }); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if err := r.Register(Unit{Name: "tiny", Dimension: "test-dim"},
+		func(v float64) float64 { return v * 1e300 },
+		func(v float64) float64 { return v / 1e300 }); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	var target *UnderflowError
+	if _, err := r.ConvertUnits("base", "tiny", 1e-300); !errors.As(err, &target) {
+		t.Fatalf("expected *UnderflowError, got %v", err)
+	}
+}
+
+func TestConvertUnitsUnderflowSkippedForAffineZeroCrossing(t *testing.T) {
+	// Converting 273.15 K to Celsius legitimately produces 0, and must
+	// not be mistaken for an underflow.
+	got, err := ConvertUnits("k", "c", 273.15)
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if got != 0 {
+		t.Fatalf("ConvertUnits(k, c, 273.15) = %g, want 0", got)
+	}
+}
+
+func TestConvertDimensionCacheReflectsNewUnits(t *testing.T) {
+	r := NewRegistry()
+	if err := r.Register(Unit{Name: "base", Dimension: "test-dim"},
+		func(v float64) float64 { return v },
+		func(v float64) float64 { return v },
+	); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	// Prime the cache for "base" before "double" even exists.
+	if _, err := r.ConvertDimensionCached("base", "base", 1); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	if err := r.Register(Unit{Name: "double", Dimension: "test-dim"},
+		func(v float64) float64 { return v * 2 },
+		func(v float64) float64 { return v / 2 },
+	); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	got, err := r.ConvertDimensionCached("base", "double", 3)
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if got != 1.5 {
+		t.Fatalf("ConvertDimensionCached(\"base\", \"double\", 3) = %v, want 1.5", got)
+	}
+}
+
+func TestConvertDimensionCachedMatchesConvertUnits(t *testing.T) {
+	got, err := ConvertDimension("ft", "in", 2)
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	want, err := ConvertUnits("ft", "in", 2)
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if got != want {
+		t.Fatalf("ConvertDimension(\"ft\", \"in\", 2) = %v, want %v", got, want)
+	}
+}
+
+func TestKeywordForUnsupported(t *testing.T) {
+	if _, err := KeywordFor("m", "kg"); !errors.Is(err, ErrUnsupportedConversion) {
+		t.Fatalf("expected ErrUnsupportedConversion for a cross-dimension pair, got %v", err)
+	}
+	if _, err := KeywordFor("m", "bogus"); !errors.Is(err, ErrUnsupportedConversion) {
+		t.Fatalf("expected ErrUnsupportedConversion for an unknown unit, got %v", err)
+	}
 }
@@ -0,0 +1,54 @@
+package converter
+
+import "fmt"
+
+// UnknownDimensionError is returned by DefaultTarget when dimension
+// isn't one of the dimensions defaultTargets covers.
+type UnknownDimensionError struct {
+	Dimension string
+}
+
+func (e *UnknownDimensionError) Error() string {
+	return fmt.Sprintf("converter: unknown dimension %q", e.Dimension)
+}
+
+// UnknownSystemError is returned by DefaultTarget when system isn't
+// "metric" or "imperial".
+type UnknownSystemError struct {
+	System string
+}
+
+func (e *UnknownSystemError) Error() string {
+	return fmt.Sprintf("converter: unknown measurement system %q, want \"metric\" or \"imperial\"", e.System)
+}
+
+// defaultTargets maps each supported dimension to the unit a UI should
+// default to displaying, per measurement system.
+var defaultTargets = map[Dimension]map[string]string{
+	DimensionLength: {
+		"metric":   "m",
+		"imperial": "ft",
+	},
+	DimensionMass: {
+		"metric":   "kg",
+		"imperial": "lb",
+	},
+}
+
+// DefaultTarget returns the unit a UI should default to displaying for
+// dimension in system ("metric" or "imperial"), e.g.
+// DefaultTarget("length", "imperial") returns "ft". Only the
+// dimensions in defaultTargets are supported; anything else returns an
+// *UnknownDimensionError, and a system other than "metric" or
+// "imperial" returns an *UnknownSystemError.
+func DefaultTarget(dimension, system string) (string, error) {
+	systems, ok := defaultTargets[Dimension(dimension)]
+	if !ok {
+		return "", &UnknownDimensionError{Dimension: dimension}
+	}
+	unit, ok := systems[system]
+	if !ok {
+		return "", &UnknownSystemError{System: system}
+	}
+	return unit, nil
+}
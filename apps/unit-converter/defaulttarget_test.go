@@ -0,0 +1,42 @@
+package converter
+
+import (
+	"errors"
+	"testing"
+)
+
+func TestDefaultTarget(t *testing.T) {
+	tests := []struct {
+		dimension string
+		system    string
+		want      string
+	}{
+		{"length", "imperial", "ft"},
+		{"length", "metric", "m"},
+		{"mass", "imperial", "lb"},
+		{"mass", "metric", "kg"},
+	}
+	for _, tc := range tests {
+		got, err := DefaultTarget(tc.dimension, tc.system)
+		if err != nil {
+			t.Fatalf("DefaultTarget(%q, %q) returned error: %v", tc.dimension, tc.system, err)
+		}
+		if got != tc.want {
+			t.Errorf("DefaultTarget(%q, %q) = %q, want %q", tc.dimension, tc.system, got, tc.want)
+		}
+	}
+}
+
+func TestDefaultTargetUnknownDimension(t *testing.T) {
+	var target *UnknownDimensionError
+	if _, err := DefaultTarget("volume", "metric"); !errors.As(err, &target) {
+		t.Fatalf("expected *UnknownDimensionError, got %v", err)
+	}
+}
+
+func TestDefaultTargetUnknownSystem(t *testing.T) {
+	var target *UnknownSystemError
+	if _, err := DefaultTarget("length", "bogus"); !errors.As(err, &target) {
+		t.Fatalf("expected *UnknownSystemError, got %v", err)
+	}
+}
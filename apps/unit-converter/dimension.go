@@ -0,0 +1,86 @@
+package converter
+
+import (
+	"fmt"
+	"strings"
+)
+
+// DimVector is a vector of exponents over the seven SI base dimensions.
+// Every compound unit used with ConvertExpr reduces to one of these;
+// two units can only be converted into each other if their DimVectors
+// are equal.
+type DimVector struct {
+	Length      int
+	Mass        int
+	Time        int
+	Temperature int
+	Current     int
+	Amount      int
+	Luminosity  int
+}
+
+// add returns the component-wise sum of two DimVectors, as produced by
+// multiplying the two unit expressions together.
+func (v DimVector) add(other DimVector) DimVector {
+	return DimVector{
+		Length:      v.Length + other.Length,
+		Mass:        v.Mass + other.Mass,
+		Time:        v.Time + other.Time,
+		Temperature: v.Temperature + other.Temperature,
+		Current:     v.Current + other.Current,
+		Amount:      v.Amount + other.Amount,
+		Luminosity:  v.Luminosity + other.Luminosity,
+	}
+}
+
+// scale returns v with every exponent multiplied by n, as produced by a
+// "^n" applied to a unit, or negated (n = -1) when a unit appears after
+// a "/".
+func (v DimVector) scale(n int) DimVector {
+	return DimVector{
+		Length:      v.Length * n,
+		Mass:        v.Mass * n,
+		Time:        v.Time * n,
+		Temperature: v.Temperature * n,
+		Current:     v.Current * n,
+		Amount:      v.Amount * n,
+		Luminosity:  v.Luminosity * n,
+	}
+}
+
+// String renders the vector as e.g. "length^1·time^-2", for use in
+// error messages. A zero vector renders as "dimensionless".
+func (v DimVector) String() string {
+	var parts []string
+	for _, d := range []struct {
+		name string
+		exp  int
+	}{
+		{"length", v.Length},
+		{"mass", v.Mass},
+		{"time", v.Time},
+		{"temperature", v.Temperature},
+		{"current", v.Current},
+		{"amount", v.Amount},
+		{"luminosity", v.Luminosity},
+	} {
+		if d.exp != 0 {
+			parts = append(parts, fmt.Sprintf("%s^%d", d.name, d.exp))
+		}
+	}
+	if len(parts) == 0 {
+		return "dimensionless"
+	}
+	return strings.Join(parts, "·")
+}
+
+// DimensionMismatchError is returned by ConvertExpr when the two sides
+// of an expression reduce to different DimVectors, e.g. converting a
+// length into a time.
+type DimensionMismatchError struct {
+	From, To DimVector
+}
+
+func (e *DimensionMismatchError) Error() string {
+	return fmt.Sprintf("converter: dimension mismatch: %s is not %s", e.From, e.To)
+}
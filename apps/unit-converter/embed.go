@@ -0,0 +1,27 @@
+package converter
+
+import (
+	"bytes"
+	_ "embed"
+	"fmt"
+)
+
+// embeddedUnitsConfig is a handful of length, mass, and volume
+// keyword conversions not already covered by the default Registry
+// (e.g. yards, stone, US quarts). Keeping them as data instead of Go
+// literals means adding one is a units.json edit, not a code change.
+//
+//go:embed units.json
+var embeddedUnitsConfig []byte
+
+// mustLoadEmbeddedConversions loads embeddedUnitsConfig via
+// LoadConversions. A failure here means the embedded file itself is
+// broken -- a build-time data problem the package ships with, not
+// something a caller could recover from -- so it panics with a clear
+// message rather than returning an error init would have nowhere to
+// report.
+func mustLoadEmbeddedConversions() {
+	if err := LoadConversions(bytes.NewReader(embeddedUnitsConfig)); err != nil {
+		panic(fmt.Sprintf("converter: failed to load embedded units.json: %v", err))
+	}
+}
@@ -0,0 +1,36 @@
+package converter
+
+import (
+	"encoding/json"
+	"testing"
+)
+
+func TestConvertStillWorksAfterEmbeddedLoad(t *testing.T) {
+	got, err := Convert("m2ft", 1)
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if want := metersToFeetFactor; got != want {
+		t.Fatalf("Convert(m2ft, 1) = %g, want %g", got, want)
+	}
+}
+
+func TestEmbeddedConversionsParse(t *testing.T) {
+	var specs []conversionSpec
+	if err := json.Unmarshal(embeddedUnitsConfig, &specs); err != nil {
+		t.Fatalf("embedded units.json does not parse: %v", err)
+	}
+	if want := 6; len(specs) != want {
+		t.Fatalf("len(specs) = %d, want %d", len(specs), want)
+	}
+}
+
+func TestEmbeddedConversionsRegistered(t *testing.T) {
+	got, err := Convert("yd2m", 10)
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if want := 9.144; got != want {
+		t.Fatalf("Convert(yd2m, 10) = %g, want %g", got, want)
+	}
+}
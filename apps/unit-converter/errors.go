@@ -0,0 +1,135 @@
+package converter
+
+import (
+	"errors"
+	"fmt"
+)
+
+// ErrUnsupportedConversion is returned by Convert when its "from2to"
+// keyword is malformed, e.g. missing the "2" separator. Callers can
+// match it with errors.Is instead of comparing error strings.
+var ErrUnsupportedConversion = errors.New("converter: unsupported conversion")
+
+// UnknownUnitError is returned when a conversion references a unit name
+// that has not been registered.
+type UnknownUnitError struct {
+	Unit string
+}
+
+func (e *UnknownUnitError) Error() string {
+	return fmt.Sprintf("converter: unknown unit %q", e.Unit)
+}
+
+// DuplicateUnitError is returned by Register when a unit name has
+// already been registered.
+type DuplicateUnitError struct {
+	Unit string
+}
+
+func (e *DuplicateUnitError) Error() string {
+	return fmt.Sprintf("converter: unit %q is already registered", e.Unit)
+}
+
+// ErrBelowAbsoluteZero is returned when a temperature value is below
+// absolute zero in its own scale, e.g. a Celsius value under -273.15.
+type ErrBelowAbsoluteZero struct {
+	Unit  string
+	Value float64
+}
+
+func (e *ErrBelowAbsoluteZero) Error() string {
+	return fmt.Sprintf("converter: %g %s is below absolute zero", e.Value, e.Unit)
+}
+
+// NonPositiveValueError is returned when a conversion that only makes
+// sense for positive values (e.g. the mpg<->L/100km reciprocal) is
+// given a zero or negative input.
+type NonPositiveValueError struct {
+	Value float64
+}
+
+func (e *NonPositiveValueError) Error() string {
+	return fmt.Sprintf("converter: value must be positive, got %g", e.Value)
+}
+
+// DuplicateConverterError is returned by RegisterConverter when keyword
+// already has a custom Converter registered.
+type DuplicateConverterError struct {
+	Keyword string
+}
+
+func (e *DuplicateConverterError) Error() string {
+	return fmt.Sprintf("converter: a converter for %q is already registered", e.Keyword)
+}
+
+// DomainError is returned by ValidateInput (and therefore ConvertStrict)
+// when value falls outside the physically meaningful domain for a
+// keyword's source unit, e.g. a negative mass or a sub-absolute-zero
+// Kelvin value.
+type DomainError struct {
+	Unit  string
+	Value float64
+}
+
+func (e *DomainError) Error() string {
+	return fmt.Sprintf("converter: %g %s is outside the valid physical domain", e.Value, e.Unit)
+}
+
+// ConversionError carries both the keyword a conversion was attempted
+// with and a human-readable reason it failed, so callers that want more
+// than a sentinel error (e.g. to show a user-facing message) can read
+// Kind and Reason directly instead of parsing Error()'s string. Unwrap
+// returns ErrUnsupportedConversion when the failure is that kind itself
+// isn't a recognized conversion; for failures that wrap a more specific
+// underlying error (e.g. a value outside its unit's valid domain),
+// Unwrap returns that error instead.
+type ConversionError struct {
+	Kind   string
+	Reason string
+	cause  error
+}
+
+func (e *ConversionError) Error() string {
+	return fmt.Sprintf("converter: %s: %s", e.Kind, e.Reason)
+}
+
+func (e *ConversionError) Unwrap() error {
+	return e.cause
+}
+
+// OverflowError is returned when a conversion's result overflows to
+// positive or negative infinity, e.g. multiplying a huge value by a
+// large unit factor. It means the result is a floating-point artifact,
+// not a physically meaningful answer.
+type OverflowError struct {
+	From, To string
+	Value    float64
+}
+
+func (e *OverflowError) Error() string {
+	return fmt.Sprintf("converter: converting %g %s to %s overflowed", e.Value, e.From, e.To)
+}
+
+// UnderflowError is returned when a conversion's nonzero result
+// underflows to exactly zero, e.g. multiplying a tiny value by a small
+// unit factor. Like OverflowError, it means the result is a
+// floating-point artifact rather than a meaningful answer.
+type UnderflowError struct {
+	From, To string
+	Value    float64
+}
+
+func (e *UnderflowError) Error() string {
+	return fmt.Sprintf("converter: converting %g %s to %s underflowed to zero", e.Value, e.From, e.To)
+}
+
+// CrossDimensionError is returned when a conversion is attempted between
+// units that belong to different dimensions (e.g. meters to Celsius).
+type CrossDimensionError struct {
+	From, To Unit
+}
+
+func (e *CrossDimensionError) Error() string {
+	return fmt.Sprintf("converter: cannot convert %s (%s) to %s (%s)",
+		e.From.Dimension, e.From.Name, e.To.Dimension, e.To.Name)
+}
@@ -0,0 +1,159 @@
+package converter
+
+import (
+	"fmt"
+	"math"
+	"strconv"
+	"strings"
+	"sync"
+)
+
+// atomicUnit is a unit expression can't be broken down any further: a
+// scalar factor against the SI-coherent combination of its DimVector
+// (e.g. "km" is 1000 of "length^1").
+type atomicUnit struct {
+	Factor float64
+	Dim    DimVector
+}
+
+var (
+	atomicUnitsOnce sync.Once
+	atomicUnitsMap  map[string]atomicUnit
+)
+
+// atomicUnits returns the symbols ConvertExpr understands inside
+// compound expressions. Unlike the main Registry, matching is
+// case-sensitive because case distinguishes real units here (N for
+// newton vs n for nano, K for kelvin vs k for kilo).
+//
+// Every length unit is derived from defaultRegistry rather than
+// re-declared here, so e.g. the SI prefixes registered by
+// mustRegisterSIPrefixes in units.go stay the single source of truth
+// for their factors and can't drift out of sync with ConvertExpr. This
+// is lazily built (instead of a package-level var) because defaultRegistry
+// isn't populated until units.go's init runs, which Go does not
+// guarantee happens before this file's package-level vars.
+func atomicUnits() map[string]atomicUnit {
+	atomicUnitsOnce.Do(func() {
+		atomicUnitsMap = map[string]atomicUnit{
+			"s":   {Factor: 1, Dim: DimVector{Time: 1}},
+			"min": {Factor: 60, Dim: DimVector{Time: 1}},
+			"h":   {Factor: 3600, Dim: DimVector{Time: 1}},
+			"kg":  {Factor: 1, Dim: DimVector{Mass: 1}},
+			"g":   {Factor: 0.001, Dim: DimVector{Mass: 1}},
+			"K":   {Factor: 1, Dim: DimVector{Temperature: 1}},
+			"N":   {Factor: 1, Dim: DimVector{Mass: 1, Length: 1, Time: -2}},
+			"J":   {Factor: 1, Dim: DimVector{Mass: 1, Length: 2, Time: -2}},
+			"W":   {Factor: 1, Dim: DimVector{Mass: 1, Length: 2, Time: -3}},
+			"Pa":  {Factor: 1, Dim: DimVector{Mass: 1, Length: -1, Time: -2}},
+		}
+		for _, unit := range defaultRegistry.Units() {
+			if unit.Dimension != DimensionLength {
+				continue
+			}
+			entry, err := defaultRegistry.lookup(unit.Name)
+			if err != nil {
+				continue
+			}
+			// Every registered length unit converts to its base (meters)
+			// with a pure scalar factor, so toBase(1) recovers it.
+			atomicUnitsMap[unit.Name] = atomicUnit{Factor: entry.toBase(1), Dim: DimVector{Length: 1}}
+		}
+	})
+	return atomicUnitsMap
+}
+
+// ConvertExpr converts value from one compound unit expression to
+// another, e.g. ConvertExpr("km/h -> m/s", 36). Expressions combine
+// atomic units with "*", "/" and "^n", such as "kg*m^2/s^2 -> J". The
+// conversion succeeds only if both sides reduce to the same DimVector;
+// otherwise a *DimensionMismatchError is returned. The returned Unit
+// describes the target expression.
+func ConvertExpr(expr string, value float64) (float64, Unit, error) {
+	sides := strings.SplitN(expr, "->", 2)
+	if len(sides) != 2 {
+		return 0, Unit{}, fmt.Errorf("converter: invalid expression %q, expected \"from -> to\"", expr)
+	}
+	fromExpr := strings.TrimSpace(sides[0])
+	toExpr := strings.TrimSpace(sides[1])
+
+	fromDim, fromFactor, err := parseUnitExpr(fromExpr)
+	if err != nil {
+		return 0, Unit{}, err
+	}
+	toDim, toFactor, err := parseUnitExpr(toExpr)
+	if err != nil {
+		return 0, Unit{}, err
+	}
+	if fromDim != toDim {
+		return 0, Unit{}, &DimensionMismatchError{From: fromDim, To: toDim}
+	}
+
+	result := value * fromFactor / toFactor
+	return result, Unit{Name: toExpr, Dimension: Dimension(toDim.String())}, nil
+}
+
+// parseUnitExpr reduces a compound unit expression such as "kg*m^2/s^2"
+// to a DimVector and the scalar factor that converts one of the
+// expression into the equivalent SI-coherent combination of base units.
+func parseUnitExpr(expr string) (DimVector, float64, error) {
+	atoms := atomicUnits()
+	var dim DimVector
+	factor := 1.0
+
+	op := byte('*')
+	var token strings.Builder
+	flush := func() error {
+		symbol, exp, err := parseUnitToken(token.String())
+		if err != nil {
+			return err
+		}
+		token.Reset()
+		if op == '/' {
+			exp = -exp
+		}
+		atom, ok := atoms[symbol]
+		if !ok {
+			return &UnknownUnitError{Unit: symbol}
+		}
+		dim = dim.add(atom.Dim.scale(exp))
+		factor *= math.Pow(atom.Factor, float64(exp))
+		return nil
+	}
+
+	for _, r := range expr {
+		switch r {
+		case ' ':
+			continue
+		case '*', '/':
+			if err := flush(); err != nil {
+				return DimVector{}, 0, err
+			}
+			op = byte(r)
+		default:
+			token.WriteRune(r)
+		}
+	}
+	if err := flush(); err != nil {
+		return DimVector{}, 0, err
+	}
+	return dim, factor, nil
+}
+
+// parseUnitToken splits a single expression token into its unit symbol
+// and exponent, e.g. "m^2" -> ("m", 2); a token with no "^" has an
+// implicit exponent of 1.
+func parseUnitToken(token string) (string, int, error) {
+	if token == "" {
+		return "", 0, fmt.Errorf("converter: empty unit in expression")
+	}
+	symbol, expStr, hasExp := strings.Cut(token, "^")
+	if !hasExp {
+		return symbol, 1, nil
+	}
+	exp, err := strconv.Atoi(expStr)
+	if err != nil {
+		return "", 0, fmt.Errorf("converter: invalid exponent in %q: %w", token, err)
+	}
+	return symbol, exp, nil
+}
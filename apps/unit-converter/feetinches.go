@@ -0,0 +1,38 @@
+package converter
+
+import (
+	"fmt"
+	"regexp"
+	"strconv"
+	"strings"
+)
+
+// feetInchesPattern matches compound feet-and-inches measurements like
+// `5'11"`, `5' 11"`, `5ft 11in`, or `6'` (zero inches is optional).
+var feetInchesPattern = regexp.MustCompile(`^(\d+(?:\.\d+)?)\s*(?:'|ft)\s*(\d+(?:\.\d+)?)?\s*(?:"|in)?$`)
+
+// ParseFeetInches parses a compound feet-and-inches measurement such
+// as `5'11"`, `5' 11"`, or `5ft 11in` and returns the total in meters.
+// The inches part is optional, so `6'` parses as 6 feet exactly.
+// Malformed input returns an error.
+func ParseFeetInches(s string) (float64, error) {
+	matches := feetInchesPattern.FindStringSubmatch(strings.TrimSpace(s))
+	if matches == nil {
+		return 0, fmt.Errorf("converter: %q is not a valid feet-and-inches measurement", s)
+	}
+
+	feet, err := strconv.ParseFloat(matches[1], 64)
+	if err != nil {
+		return 0, fmt.Errorf("converter: %q is not a valid feet-and-inches measurement", s)
+	}
+
+	var inches float64
+	if matches[2] != "" {
+		inches, err = strconv.ParseFloat(matches[2], 64)
+		if err != nil {
+			return 0, fmt.Errorf("converter: %q is not a valid feet-and-inches measurement", s)
+		}
+	}
+
+	return (feet*12 + inches) * inToMetersFactor, nil
+}
@@ -0,0 +1,170 @@
+package converter
+
+import (
+	"fmt"
+	"math"
+	"strconv"
+	"strings"
+)
+
+// temperatureLabels maps the canonical temperature unit names to the
+// degree-symbol form people expect in prose, e.g. "c" -> "°C". Every
+// other dimension's unit names (m, ft, kg, ...) already read fine as
+// their canonical Name, so only temperature needs an override here.
+var temperatureLabels = map[string]string{
+	"k":  "K",
+	"c":  "°C",
+	"f":  "°F",
+	"r":  "°R",
+	"re": "°Ré",
+	"de": "°De",
+}
+
+// unitLabel returns the human-readable label for a unit name as it
+// would be shown in formatted output.
+func unitLabel(name string) string {
+	if label, ok := temperatureLabels[name]; ok {
+		return label
+	}
+	return name
+}
+
+// asciiTemperatureLabels is temperatureLabels' ASCII-safe counterpart,
+// for terminals and encodings that can't render "°".
+var asciiTemperatureLabels = map[string]string{
+	"k":  "K",
+	"c":  "degC",
+	"f":  "degF",
+	"r":  "degR",
+	"re": "degRe",
+	"de": "degDe",
+}
+
+// unitLabelASCII is unitLabel's ASCII-safe counterpart: it avoids "°"
+// for temperature units, and replaces the SI micro prefix "µ" with
+// "u" for any other unit (e.g. "µm" becomes "um").
+func unitLabelASCII(name string) string {
+	if label, ok := asciiTemperatureLabels[name]; ok {
+		return label
+	}
+	return strings.ReplaceAll(name, "µ", "u")
+}
+
+// formatValue renders v with trailing zeros trimmed (e.g. 32 instead of
+// 32.000000). v is rounded to 9 decimal places first so floating-point
+// noise from affine temperature transforms (e.g. 31.999999999999986)
+// doesn't leak into otherwise-exact results.
+func formatValue(v float64) string {
+	const precision = 9
+	scale := math.Pow(10, precision)
+	v = math.Round(v*scale) / scale
+	return strconv.FormatFloat(v, 'f', -1, 64)
+}
+
+// ConvertFormatted is like Convert but renders the result as a
+// human-readable string, e.g. ConvertFormatted("m2ft", 1) returns
+// "1 m = 3.28084 ft". It is meant for CLI and chat-bot output where a
+// bare float isn't self-explanatory.
+func ConvertFormatted(kind string, value float64) (string, error) {
+	result, err := Convert(kind, value)
+	if err != nil {
+		return "", err
+	}
+
+	from, to, err := splitKeyword(kind)
+	if err != nil {
+		return "", err
+	}
+
+	return fmt.Sprintf("%s %s = %s %s", formatValue(value), unitLabel(from), formatValue(result), unitLabel(to)), nil
+}
+
+// ConvertFormattedASCII is like ConvertFormatted but renders unit
+// labels using only ASCII characters, e.g. "degC" instead of "°C" and
+// "um" instead of "µm", for terminals and encodings that can't render
+// those characters.
+func ConvertFormattedASCII(kind string, value float64) (string, error) {
+	result, err := Convert(kind, value)
+	if err != nil {
+		return "", err
+	}
+
+	from, to, err := splitKeyword(kind)
+	if err != nil {
+		return "", err
+	}
+
+	return fmt.Sprintf("%s %s = %s %s", formatValue(value), unitLabelASCII(from), formatValue(result), unitLabelASCII(to)), nil
+}
+
+// ConvertScientific is like Convert but renders the result in
+// normalized scientific notation with sigFigs significant figures,
+// e.g. ConvertScientific("b2tib", 5e12, 3) returns "4.55e+00". It is
+// meant for results that are unreadable as plain decimals, such as
+// bytes converted to terabytes or pascals converted to atmospheres.
+// sigFigs must be at least 1.
+func ConvertScientific(kind string, value float64, sigFigs int) (string, error) {
+	if sigFigs < 1 {
+		return "", fmt.Errorf("converter: sigFigs must be at least 1, got %d", sigFigs)
+	}
+
+	result, err := Convert(kind, value)
+	if err != nil {
+		return "", err
+	}
+	return strconv.FormatFloat(result, 'e', sigFigs-1, 64), nil
+}
+
+// localizeNumber rewrites s (a plain decimal number as produced by
+// formatValue, using "." for the decimal point and no thousands
+// grouping) to use decimalSep in place of "." and thousandsSep grouped
+// every three digits of the integer part. A leading "-" is preserved
+// ahead of the grouping.
+func localizeNumber(s, decimalSep, thousandsSep string) string {
+	negative := strings.HasPrefix(s, "-")
+	if negative {
+		s = s[1:]
+	}
+
+	intPart, fracPart, hasFrac := strings.Cut(s, ".")
+
+	var grouped strings.Builder
+	for i, digit := range intPart {
+		if i > 0 && (len(intPart)-i)%3 == 0 {
+			grouped.WriteString(thousandsSep)
+		}
+		grouped.WriteRune(digit)
+	}
+
+	var sb strings.Builder
+	if negative {
+		sb.WriteString("-")
+	}
+	sb.WriteString(grouped.String())
+	if hasFrac {
+		sb.WriteString(decimalSep)
+		sb.WriteString(fracPart)
+	}
+	return sb.String()
+}
+
+// ConvertFormattedLocale is like ConvertFormatted but renders the
+// result's number using decimalSep in place of the decimal point and
+// thousandsSep grouped every three digits of the integer part, e.g.
+// ConvertFormattedLocale("m2ft", 1234.5, ",", ".") renders the result
+// with European-style separators instead of "1,234.5".
+func ConvertFormattedLocale(kind string, value float64, decimalSep, thousandsSep string) (string, error) {
+	result, err := Convert(kind, value)
+	if err != nil {
+		return "", err
+	}
+
+	from, to, err := splitKeyword(kind)
+	if err != nil {
+		return "", err
+	}
+
+	localizedValue := localizeNumber(formatValue(value), decimalSep, thousandsSep)
+	localizedResult := localizeNumber(formatValue(result), decimalSep, thousandsSep)
+	return fmt.Sprintf("%s %s = %s %s", localizedValue, unitLabel(from), localizedResult, unitLabel(to)), nil
+}
@@ -0,0 +1,38 @@
+package converter
+
+// positiveOnly rejects zero and negative values, which would make the
+// mpg<->L/100km reciprocal divide by zero or produce a nonsensical
+// negative fuel economy.
+func positiveOnly(v float64) error {
+	if v <= 0 {
+		return &NonPositiveValueError{Value: v}
+	}
+	return nil
+}
+
+// registerFuelEconomyUnits registers the fuel-economy dimension, using
+// US MPG as the base unit with L/100km as the only other unit. Unlike
+// every other dimension, MPG and L/100km relate by a reciprocal
+// (mpg = mpgToLper100kmConstant / lper100km) rather than a linear
+// factor, so the registered toBase/fromBase functions apply that
+// formula directly instead of a multiplication.
+func registerFuelEconomyUnits(r *Registry) {
+	reciprocal := func(v float64) float64 { return mpgToLper100kmConstant / v }
+
+	if err := r.RegisterChecked(
+		Unit{Name: "mpg", Dimension: DimensionFuelEconomy},
+		func(v float64) float64 { return v },
+		func(v float64) float64 { return v },
+		positiveOnly,
+	); err != nil {
+		panic(err)
+	}
+	if err := r.RegisterChecked(
+		Unit{Name: "lper100km", Dimension: DimensionFuelEconomy},
+		reciprocal,
+		reciprocal,
+		positiveOnly,
+	); err != nil {
+		panic(err)
+	}
+}
@@ -0,0 +1,69 @@
+package converter
+
+import (
+	"flag"
+	"fmt"
+	"os"
+	"strings"
+	"testing"
+)
+
+// update regenerates testdata/conversions.golden from the current
+// behavior of Convert. Run "go test -run TestConversionsGolden -update"
+// after intentionally changing a conversion factor or adding units.
+var update = flag.Bool("update", false, "update the golden file")
+
+const goldenPath = "testdata/conversions.golden"
+
+// goldenKeywords is captured by TestMain before any other test runs,
+// since several tests (e.g. TestConvertConcurrentRegistration) register
+// extra units on the shared defaultRegistry and never remove them,
+// which would otherwise make ListConversions' result depend on test
+// execution order.
+var goldenKeywords []string
+
+func TestMain(m *testing.M) {
+	goldenKeywords = ListConversions()
+	os.Exit(m.Run())
+}
+
+// goldenConversions runs every keyword captured in goldenKeywords
+// through Convert with a fixed input of 1.0, rendering each result (or
+// error) as one "keyword\tresult" line so the whole keyword set's
+// behavior is visible, and reviewable, in a single diff.
+func goldenConversions() string {
+	var lines []string
+	for _, keyword := range goldenKeywords {
+		result, err := Convert(keyword, 1.0)
+		if err != nil {
+			lines = append(lines, fmt.Sprintf("%s\t%s", keyword, err))
+			continue
+		}
+		lines = append(lines, fmt.Sprintf("%s\t%s", keyword, formatValue(result)))
+	}
+	return strings.Join(lines, "\n") + "\n"
+}
+
+// TestConversionsGolden guards against an unintentional change to any
+// built-in keyword's conversion factor or error behavior: a change to
+// units.go, temperature.go, or any other file that alters what Convert
+// returns for a given keyword shows up as a diff against
+// testdata/conversions.golden instead of silently passing.
+func TestConversionsGolden(t *testing.T) {
+	got := goldenConversions()
+
+	if *update {
+		if err := os.WriteFile(goldenPath, []byte(got), 0o644); err != nil {
+			t.Fatalf("failed to write golden file: %v", err)
+		}
+		return
+	}
+
+	want, err := os.ReadFile(goldenPath)
+	if err != nil {
+		t.Fatalf("failed to read golden file: %v (run with -update to create it)", err)
+	}
+	if got != string(want) {
+		t.Errorf("conversions golden mismatch; run \"go test -run TestConversionsGolden -update\" to review and accept the new output\n--- got ---\n%s", got)
+	}
+}
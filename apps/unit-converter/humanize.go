@@ -0,0 +1,72 @@
+package converter
+
+import "fmt"
+
+// humanizeStep is one rung of a Humanize scale: once the absolute base
+// value reaches Threshold (expressed in the dimension's base unit), the
+// result is rendered in Unit instead of the previous, smaller rung.
+type humanizeStep struct {
+	Threshold float64
+	Unit      string
+}
+
+// humanizeScale describes how Humanize picks a display unit for a
+// dimension: BaseUnit is the registry unit baseValue is expressed in,
+// and Steps must be sorted ascending by Threshold, starting at 0.
+type humanizeScale struct {
+	BaseUnit string
+	Steps    []humanizeStep
+}
+
+// humanizeScales covers the dimensions Humanize knows how to pick a
+// "natural" display unit for. Adding a dimension here only requires the
+// base unit and the thresholds at which a larger unit reads better.
+var humanizeScales = map[Dimension]humanizeScale{
+	DimensionLength: {
+		BaseUnit: "m",
+		Steps: []humanizeStep{
+			{Threshold: 0, Unit: "mm"},
+			{Threshold: 1, Unit: "m"},
+			{Threshold: 1000, Unit: "km"},
+		},
+	},
+	DimensionMass: {
+		BaseUnit: "kg",
+		Steps: []humanizeStep{
+			{Threshold: 0, Unit: "mg"},
+			{Threshold: 0.001, Unit: "g"},
+			{Threshold: 1, Unit: "kg"},
+			{Threshold: 1000, Unit: "t"},
+		},
+	},
+}
+
+// Humanize renders baseValue (expressed in dimension's base unit, e.g.
+// meters for DimensionLength) using whichever registered unit for that
+// dimension reads most naturally, e.g. Humanize(DimensionLength, 1500)
+// returns "1.5 km" rather than "1500 m". Only dimensions listed in
+// humanizeScales are supported; any other dimension returns an error.
+func Humanize(dimension string, baseValue float64) (string, error) {
+	scale, ok := humanizeScales[Dimension(dimension)]
+	if !ok {
+		return "", fmt.Errorf("converter: humanize not supported for dimension %q", dimension)
+	}
+
+	abs := baseValue
+	if abs < 0 {
+		abs = -abs
+	}
+	step := scale.Steps[0]
+	for _, s := range scale.Steps {
+		if abs < s.Threshold {
+			break
+		}
+		step = s
+	}
+
+	result, err := defaultRegistry.ConvertUnits(scale.BaseUnit, step.Unit, baseValue)
+	if err != nil {
+		return "", err
+	}
+	return fmt.Sprintf("%s %s", formatValue(result), unitLabel(step.Unit)), nil
+}
@@ -0,0 +1,36 @@
+package converter
+
+import "encoding/json"
+
+// conversionResult is the JSON shape returned by ConvertJSON.
+type conversionResult struct {
+	Kind     string  `json:"kind"`
+	Input    float64 `json:"input"`
+	Output   float64 `json:"output"`
+	FromUnit string  `json:"fromUnit"`
+	ToUnit   string  `json:"toUnit"`
+}
+
+// ConvertJSON is like Convert but marshals the result into a JSON
+// object carrying the keyword, input, output, and the two unit names,
+// e.g. {"kind":"m2ft","input":1,"output":3.28084,"fromUnit":"m","toUnit":"ft"}.
+// A conversion failure is returned as a Go error, not embedded in the
+// JSON, so callers don't have to distinguish success from failure by
+// inspecting the payload.
+func ConvertJSON(kind string, value float64) ([]byte, error) {
+	from, to, err := splitKeyword(kind)
+	if err != nil {
+		return nil, err
+	}
+	result, err := Convert(kind, value)
+	if err != nil {
+		return nil, err
+	}
+	return json.Marshal(conversionResult{
+		Kind:     kind,
+		Input:    value,
+		Output:   result,
+		FromUnit: from,
+		ToUnit:   to,
+	})
+}
@@ -0,0 +1,76 @@
+package converter
+
+import (
+	"encoding/json"
+	"fmt"
+	"io"
+)
+
+// InvalidConversionSpecError is returned by LoadConversions when an
+// entry in the config is missing a required field.
+type InvalidConversionSpecError struct {
+	Keyword string
+	Reason  string
+}
+
+func (e *InvalidConversionSpecError) Error() string {
+	return fmt.Sprintf("converter: conversion spec %q is invalid: %s", e.Keyword, e.Reason)
+}
+
+// conversionSpec is the JSON shape LoadConversions reads, e.g.
+// {"keyword":"yd2m","from":"yd","to":"m","factor":0.9144,"dimension":"length"}.
+// Dimension is informational only: a custom Converter (unlike a
+// Registry unit) converts directly between its two fixed units, so
+// nothing checks that Dimension agrees with From and To.
+type conversionSpec struct {
+	Keyword   string  `json:"keyword"`
+	From      string  `json:"from"`
+	To        string  `json:"to"`
+	Factor    float64 `json:"factor"`
+	Dimension string  `json:"dimension"`
+}
+
+// factorConverter is a Converter that applies a constant multiplicative
+// factor, the shape LoadConversions registers each spec as.
+type factorConverter struct {
+	from, to string
+	factor   float64
+}
+
+func (c factorConverter) Convert(value float64) (float64, error) {
+	return value * c.factor, nil
+}
+
+func (c factorConverter) Units() (from, to string) {
+	return c.from, c.to
+}
+
+// LoadConversions reads a JSON array of conversion specs from r and
+// registers each one as a custom Converter via RegisterConverter, so
+// e.g. Convert("yd2m", 1) works immediately afterward. Malformed JSON
+// returns an error from json.Unmarshal wrapped with context; a spec
+// missing its keyword, from, or to field returns an
+// *InvalidConversionSpecError; and a keyword that collides with an
+// already-registered custom Converter returns the
+// *DuplicateConverterError RegisterConverter would have returned. A
+// failure partway through leaves any specs already registered in
+// place.
+func LoadConversions(r io.Reader) error {
+	var specs []conversionSpec
+	if err := json.NewDecoder(r).Decode(&specs); err != nil {
+		return fmt.Errorf("converter: malformed conversion config: %w", err)
+	}
+
+	for _, spec := range specs {
+		if spec.Keyword == "" {
+			return &InvalidConversionSpecError{Keyword: spec.Keyword, Reason: "missing keyword"}
+		}
+		if spec.From == "" || spec.To == "" {
+			return &InvalidConversionSpecError{Keyword: spec.Keyword, Reason: "missing from or to unit"}
+		}
+		if err := RegisterConverter(spec.Keyword, factorConverter{from: spec.From, to: spec.To, factor: spec.Factor}); err != nil {
+			return err
+		}
+	}
+	return nil
+}
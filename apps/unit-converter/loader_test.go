@@ -0,0 +1,56 @@
+package converter
+
+import (
+	"errors"
+	"strings"
+	"testing"
+)
+
+func TestLoadConversions(t *testing.T) {
+	const config = `[{"keyword":"loadertest12yd2m","from":"yd","to":"m","factor":0.9144,"dimension":"length"}]`
+	if err := LoadConversions(strings.NewReader(config)); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	got, err := Convert("loadertest12yd2m", 10)
+	if err != nil {
+		t.Fatalf("unexpected error converting with loaded keyword: %v", err)
+	}
+	if want := 9.144; got != want {
+		t.Errorf("Convert(loadertest12yd2m, 10) = %g, want %g", got, want)
+	}
+}
+
+func TestLoadConversionsDuplicateKeyword(t *testing.T) {
+	const config = `[{"keyword":"loadertestdup2m","from":"yd","to":"m","factor":0.9144}]`
+	if err := LoadConversions(strings.NewReader(config)); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	var target *DuplicateConverterError
+	if err := LoadConversions(strings.NewReader(config)); !errors.As(err, &target) {
+		t.Fatalf("expected *DuplicateConverterError, got %v", err)
+	}
+}
+
+func TestLoadConversionsMalformedJSON(t *testing.T) {
+	if err := LoadConversions(strings.NewReader("not json")); err == nil {
+		t.Fatal("expected an error for malformed JSON")
+	}
+}
+
+func TestLoadConversionsMissingKeyword(t *testing.T) {
+	const config = `[{"from":"yd","to":"m","factor":0.9144}]`
+	var target *InvalidConversionSpecError
+	if err := LoadConversions(strings.NewReader(config)); !errors.As(err, &target) {
+		t.Fatalf("expected *InvalidConversionSpecError, got %v", err)
+	}
+}
+
+func TestLoadConversionsMissingUnit(t *testing.T) {
+	const config = `[{"keyword":"loadertestnounit","from":"yd","factor":0.9144}]`
+	var target *InvalidConversionSpecError
+	if err := LoadConversions(strings.NewReader(config)); !errors.As(err, &target) {
+		t.Fatalf("expected *InvalidConversionSpecError, got %v", err)
+	}
+}
@@ -0,0 +1,129 @@
+package converter
+
+import "sort"
+
+// Info describes a single "from2to" conversion keyword: the units it
+// converts between and the dimension they share. It is meant for UIs
+// that want to show something like "meters -> feet (length)" without
+// hardcoding unit names or dimensions that could drift out of sync with
+// what Convert actually supports.
+type Info struct {
+	Keyword   string
+	FromUnit  string
+	ToUnit    string
+	Dimension string
+}
+
+// Describe returns metadata about the "from2to" keyword kind, backed by
+// the same default registry Convert uses. It returns the same errors
+// ConvertUnits would for an unknown unit or a cross-dimension pairing,
+// without performing the conversion itself.
+func Describe(kind string) (Info, error) {
+	from, to, err := splitKeyword(kind)
+	if err != nil {
+		return Info{}, err
+	}
+
+	defaultRegistry.mu.RLock()
+	defer defaultRegistry.mu.RUnlock()
+
+	fromEntry, err := defaultRegistry.lookup(from)
+	if err != nil {
+		return Info{}, err
+	}
+	toEntry, err := defaultRegistry.lookup(to)
+	if err != nil {
+		return Info{}, err
+	}
+	if fromEntry.unit.Dimension != toEntry.unit.Dimension {
+		return Info{}, &CrossDimensionError{From: fromEntry.unit, To: toEntry.unit}
+	}
+
+	return Info{
+		Keyword:   kind,
+		FromUnit:  fromEntry.unit.Name,
+		ToUnit:    toEntry.unit.Name,
+		Dimension: string(fromEntry.unit.Dimension),
+	}, nil
+}
+
+// KeywordFor returns the "from2to" keyword that converts from to to --
+// the reverse of what Describe decodes a keyword into. It's meant for
+// UIs that let a user pick two units (e.g. from dropdowns) and need the
+// keyword Convert expects, without hardcoding the "2" naming
+// convention. ErrUnsupportedConversion is returned if from or to isn't
+// a registered unit, or if the two don't share a dimension.
+func KeywordFor(from, to string) (string, error) {
+	defaultRegistry.mu.RLock()
+	defer defaultRegistry.mu.RUnlock()
+
+	fromEntry, err := defaultRegistry.lookup(from)
+	if err != nil {
+		return "", ErrUnsupportedConversion
+	}
+	toEntry, err := defaultRegistry.lookup(to)
+	if err != nil {
+		return "", ErrUnsupportedConversion
+	}
+	if fromEntry.unit.Dimension != toEntry.unit.Dimension {
+		return "", ErrUnsupportedConversion
+	}
+
+	return fromEntry.unit.Name + "2" + toEntry.unit.Name, nil
+}
+
+// ByDimension returns metadata for every "from2to" keyword Convert
+// supports within the given dimension (e.g. "length" or "temperature"),
+// sorted by keyword. Like ListConversions, it is derived from Units so
+// it can never list a keyword Convert would reject.
+func ByDimension(dimension string) []Info {
+	var names []string
+	for _, unit := range defaultRegistry.Units() {
+		if string(unit.Dimension) == dimension {
+			names = append(names, unit.Name)
+		}
+	}
+	sort.Strings(names)
+
+	var infos []Info
+	for _, from := range names {
+		for _, to := range names {
+			if from == to {
+				continue
+			}
+			infos = append(infos, Info{
+				Keyword:   from + "2" + to,
+				FromUnit:  from,
+				ToUnit:    to,
+				Dimension: dimension,
+			})
+		}
+	}
+	return infos
+}
+
+// ConvertToAll converts value, expressed in unit, into every other unit
+// registered for unit's dimension, keyed by each target unit's
+// canonical name (unit's own entry is included). An UnknownUnitError is
+// returned if unit isn't registered.
+func ConvertToAll(unit string, value float64) (map[string]float64, error) {
+	defaultRegistry.mu.RLock()
+	fromEntry, err := defaultRegistry.lookup(unit)
+	defaultRegistry.mu.RUnlock()
+	if err != nil {
+		return nil, err
+	}
+
+	results := make(map[string]float64)
+	for _, u := range defaultRegistry.Units() {
+		if u.Dimension != fromEntry.unit.Dimension {
+			continue
+		}
+		result, err := defaultRegistry.ConvertUnits(fromEntry.unit.Name, u.Name, value)
+		if err != nil {
+			return nil, err
+		}
+		results[u.Name] = result
+	}
+	return results, nil
+}
@@ -0,0 +1,85 @@
+package converter
+
+import (
+	"fmt"
+	"strconv"
+	"strings"
+)
+
+// ParseAndConvert parses a natural-language conversion expression of
+// the form "<number> <unit> to <unit>" (the word "in" is also accepted
+// in place of "to") and returns the converted value. Whitespace is
+// insignificant and unit names and the connecting word are matched
+// case-insensitively, so "10 M TO Ft" works the same as "10 m to ft".
+func ParseAndConvert(expr string) (float64, error) {
+	fields := strings.Fields(expr)
+	if len(fields) != 4 {
+		return 0, fmt.Errorf("converter: invalid expression %q, expected \"<number> <unit> to <unit>\"", expr)
+	}
+
+	value, err := strconv.ParseFloat(fields[0], 64)
+	if err != nil {
+		return 0, fmt.Errorf("converter: invalid number %q in expression %q", fields[0], expr)
+	}
+
+	from := fields[1]
+	connector := strings.ToLower(fields[2])
+	to := fields[3]
+	if connector != "to" && connector != "in" {
+		return 0, fmt.Errorf("converter: invalid expression %q, expected \"to\" or \"in\" before the target unit", expr)
+	}
+
+	return ConvertUnits(from, to, value)
+}
+
+// ConvertMixed parses a sum of same-dimension quantities and converts
+// the total to a target unit, e.g. ConvertMixed("3 ft + 5 in to cm")
+// converts 3 ft and 5 in to cm separately, sums them, and returns
+// roughly 104.14. Terms are separated by "+", and (as in
+// ParseAndConvert) the connecting word before the target unit may be
+// "to" or "in". A malformed expression, or terms that don't all share
+// the target unit's dimension, returns an error.
+func ConvertMixed(expr string) (float64, error) {
+	fields := strings.Fields(expr)
+	if len(fields) < 4 {
+		return 0, fmt.Errorf("converter: invalid expression %q, expected \"<number> <unit> [+ <number> <unit> ...] to <unit>\"", expr)
+	}
+
+	connector := strings.ToLower(fields[len(fields)-2])
+	if connector != "to" && connector != "in" {
+		return 0, fmt.Errorf("converter: invalid expression %q, expected \"to\" or \"in\" before the target unit", expr)
+	}
+	target := fields[len(fields)-1]
+	termTokens := fields[:len(fields)-2]
+
+	var total float64
+	i := 0
+	for i < len(termTokens) {
+		if i+1 >= len(termTokens) {
+			return 0, fmt.Errorf("converter: invalid expression %q, term %q is missing a unit", expr, termTokens[i])
+		}
+		value, err := strconv.ParseFloat(termTokens[i], 64)
+		if err != nil {
+			return 0, fmt.Errorf("converter: invalid number %q in expression %q", termTokens[i], expr)
+		}
+		unit := termTokens[i+1]
+		converted, err := ConvertUnits(unit, target, value)
+		if err != nil {
+			return 0, err
+		}
+		total += converted
+		i += 2
+
+		if i < len(termTokens) {
+			if termTokens[i] != "+" {
+				return 0, fmt.Errorf("converter: invalid expression %q, expected \"+\" between terms", expr)
+			}
+			i++
+			if i >= len(termTokens) {
+				return 0, fmt.Errorf("converter: invalid expression %q, expected a term after \"+\"", expr)
+			}
+		}
+	}
+
+	return total, nil
+}
@@ -0,0 +1,32 @@
+package converter
+
+import "testing"
+
+// FuzzParseAndConvert checks that ParseAndConvert never panics or
+// hangs, regardless of input -- it should always return either a
+// result or an error. Run it directly with:
+//
+//	go test ./apps/unit-converter -fuzz FuzzParseAndConvert
+func FuzzParseAndConvert(f *testing.F) {
+	seeds := []string{
+		"10 m to ft",
+		"0 c to f",
+		"  10   m   to   ft  ",
+		"10 M IN Ft",
+		"",
+		"to",
+		"1e308 m to km",
+		"NaN m to ft",
+		"-1 k to c",
+		"10 m to m to m",
+		"10 мм to ft",
+		"10 m 🚀 ft",
+	}
+	for _, s := range seeds {
+		f.Add(s)
+	}
+
+	f.Fuzz(func(t *testing.T, expr string) {
+		_, _ = ParseAndConvert(expr)
+	})
+}
@@ -0,0 +1,48 @@
+package converter
+
+import "sync"
+
+// Converter lets third-party code plug a custom conversion into Convert
+// for keywords that don't fit the Registry's unit-to-unit model, e.g.
+// shoe or clothing sizes that don't share a common base unit with
+// anything else. Units reports the unit pair the Converter handles, for
+// callers that want to describe it (e.g. a UI's "swap units" control).
+type Converter interface {
+	Convert(value float64) (float64, error)
+	Units() (from, to string)
+}
+
+// customConverters holds Converters registered via RegisterConverter,
+// keyed by the "from2to" keyword they handle. customConvertersMu guards
+// it so registering from one goroutine while Convert reads from
+// another is safe.
+var (
+	customConvertersMu sync.RWMutex
+	customConverters   = make(map[string]Converter)
+)
+
+// RegisterConverter registers c to handle kind, the same "from2to"
+// keyword Convert accepts. Convert always checks the default Registry
+// first, so a custom Converter only runs when kind is not already a
+// supported built-in conversion. Registering a kind that already has a
+// custom Converter returns a *DuplicateConverterError.
+func RegisterConverter(kind string, c Converter) error {
+	customConvertersMu.Lock()
+	defer customConvertersMu.Unlock()
+
+	if _, exists := customConverters[kind]; exists {
+		return &DuplicateConverterError{Keyword: kind}
+	}
+	customConverters[kind] = c
+	return nil
+}
+
+// lookupCustomConverter returns the Converter registered for kind, if
+// any.
+func lookupCustomConverter(kind string) (Converter, bool) {
+	customConvertersMu.RLock()
+	defer customConvertersMu.RUnlock()
+
+	c, ok := customConverters[kind]
+	return c, ok
+}
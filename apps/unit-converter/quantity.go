@@ -0,0 +1,20 @@
+package converter
+
+// To converts q into the given unit and returns the result as a new
+// Quantity, so conversions can be chained fluently, e.g.
+// Quantity{Value: 1, Unit: "m"}.To("ft"). It returns a CrossDimensionError
+// if unit doesn't share q.Unit's dimension, or an UnknownUnitError if
+// either name isn't registered.
+func (q Quantity) To(unit string) (Quantity, error) {
+	value, err := ConvertUnits(q.Unit, unit, q.Value)
+	if err != nil {
+		return Quantity{}, err
+	}
+	return Quantity{Value: value, Unit: unit}, nil
+}
+
+// String renders q as e.g. "1 m", using the same number formatting and
+// unit labels as ConvertFormatted.
+func (q Quantity) String() string {
+	return formatValue(q.Value) + " " + unitLabel(q.Unit)
+}
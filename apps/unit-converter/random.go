@@ -0,0 +1,60 @@
+package converter
+
+import "math/rand"
+
+// randomRange is the width of the domain RandomValid draws from, above
+// whatever floor the source unit's dimension imposes (or centered on
+// zero for dimensions with no floor). It is arbitrary but fixed, so
+// RandomValid's output only depends on rng's seed.
+const randomRange = 1000.0
+
+// RandomValid picks a pseudo-random value within the physically
+// meaningful domain of kind's source unit (respecting the
+// absolute-zero floor for temperatures and non-negativity for mass,
+// length, and volume, the same domain ValidateInput enforces), converts
+// it, and returns both the input and the result. rng is injected so
+// callers get reproducible output for a given seed instead of depending
+// on the global math/rand source.
+func RandomValid(kind string, rng *rand.Rand) (value float64, result float64, err error) {
+	from, _, err := splitKeyword(kind)
+	if err != nil {
+		return 0, 0, err
+	}
+	unitName, ok := CanonicalUnit(from)
+	if !ok {
+		return 0, 0, &UnknownUnitError{Unit: from}
+	}
+
+	floor, hasFloor := domainFloor(unitName)
+	if hasFloor {
+		value = floor + rng.Float64()*randomRange
+	} else {
+		value = (rng.Float64()*2 - 1) * randomRange
+	}
+
+	result, err = Convert(kind, value)
+	return value, result, err
+}
+
+// domainFloor returns the lowest physically meaningful value for
+// unitName, and whether such a floor exists at all. Temperature units
+// floor at absolute zero, expressed in their own scale; mass, length,
+// and volume units floor at zero.
+func domainFloor(unitName string) (floor float64, ok bool) {
+	for _, u := range Units() {
+		if u.Name != unitName {
+			continue
+		}
+		switch {
+		case u.Dimension == DimensionTemperature:
+			absoluteZero, err := ConvertUnits("k", unitName, 0)
+			if err != nil {
+				return 0, false
+			}
+			return absoluteZero, true
+		case nonNegativeDimensions[u.Dimension]:
+			return 0, true
+		}
+	}
+	return 0, false
+}
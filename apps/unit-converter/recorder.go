@@ -0,0 +1,38 @@
+package converter
+
+// Recorder observes successful conversions performed through
+// ConvertWithRecorder, e.g. to build an audit log or history view on
+// top of the converter.
+type Recorder interface {
+	Record(kind string, in, out float64)
+}
+
+// ConvertWithRecorder is like Convert, but on success it also calls
+// r.Record with the keyword, input, and output values. Failed
+// conversions are not recorded.
+func ConvertWithRecorder(kind string, value float64, r Recorder) (float64, error) {
+	result, err := Convert(kind, value)
+	if err != nil {
+		return 0, err
+	}
+	r.Record(kind, value, result)
+	return result, nil
+}
+
+// HistoryEntry is one conversion recorded by a SliceRecorder.
+type HistoryEntry struct {
+	Kind string
+	In   float64
+	Out  float64
+}
+
+// SliceRecorder is a Recorder that appends every conversion to
+// Entries, for simple in-memory history tracking or test inspection.
+type SliceRecorder struct {
+	Entries []HistoryEntry
+}
+
+// Record implements Recorder by appending an entry to Entries.
+func (s *SliceRecorder) Record(kind string, in, out float64) {
+	s.Entries = append(s.Entries, HistoryEntry{Kind: kind, In: in, Out: out})
+}
@@ -0,0 +1,303 @@
+package converter
+
+import (
+	"math"
+	"strings"
+	"sync"
+)
+
+// Dimension identifies the physical quantity a Unit measures. Units can
+// only be converted to other units that share the same Dimension.
+type Dimension string
+
+// Dimensions supported by the registry. Not every dimension has units
+// registered yet; new units can be added for any of these without
+// touching Convert or ConvertUnits.
+const (
+	DimensionLength      Dimension = "length"
+	DimensionTemperature Dimension = "temperature"
+	DimensionMass        Dimension = "mass"
+	DimensionVolume      Dimension = "volume"
+	DimensionTime        Dimension = "time"
+	DimensionPressure    Dimension = "pressure"
+	DimensionSpeed       Dimension = "speed"
+	DimensionEnergy      Dimension = "energy"
+	DimensionData        Dimension = "data"
+	DimensionAngle       Dimension = "angle"
+	DimensionFuelEconomy Dimension = "fuel-economy"
+	DimensionForce       Dimension = "force"
+)
+
+// Unit describes a single unit of measurement and the dimension it
+// belongs to.
+type Unit struct {
+	Name      string
+	Dimension Dimension
+}
+
+// Quantity pairs a value with the name of the unit it is expressed in,
+// e.g. Quantity{Value: 1, Unit: "m"}. See To and String for the
+// fluent, chainable operations built on top of Convert.
+type Quantity struct {
+	Value float64
+	Unit  string
+}
+
+// unitEntry is how the registry stores a unit alongside the functions
+// needed to move a value to and from the dimension's base unit.
+type unitEntry struct {
+	unit     Unit
+	toBase   func(float64) float64
+	fromBase func(float64) float64
+	validate func(float64) error
+}
+
+// Registry holds the set of units known to a converter, keyed by
+// lower-cased unit name. A Registry is safe to extend at any time via
+// Register, including by third-party packages. Registration is meant
+// to happen at init time, but mu makes every method safe for
+// concurrent use regardless, so a package that registers units lazily
+// or from multiple goroutines doesn't need its own locking.
+type Registry struct {
+	mu          sync.RWMutex
+	units       map[string]unitEntry
+	aliases     map[string]string // normalized alias -> normalized canonical name
+	generation  uint64
+	factorCache sync.Map // lookupCacheKey -> unitEntry, used by lookupCached
+}
+
+// lookupCacheKey is factorCache's key: the generation guards against a
+// newly registered unit being masked by a cache entry recorded before
+// it existed, without needing to scan and evict the cache on every
+// Register call.
+type lookupCacheKey struct {
+	generation uint64
+	name       string
+}
+
+// NewRegistry returns an empty Registry.
+func NewRegistry() *Registry {
+	return &Registry{
+		units:   make(map[string]unitEntry),
+		aliases: make(map[string]string),
+	}
+}
+
+// Register adds a unit to the registry. toBase converts a value in unit
+// to the dimension's base unit; fromBase converts a base-unit value back
+// to unit. Registering a name that already exists returns an error.
+func (r *Registry) Register(unit Unit, toBase, fromBase func(float64) float64) error {
+	return r.RegisterChecked(unit, toBase, fromBase, nil)
+}
+
+// RegisterChecked is like Register but also attaches a validate function
+// that is run against the input value whenever the unit is used as the
+// source of a conversion; a non-nil error aborts the conversion before
+// toBase is applied. Pass a nil validate to skip the check.
+func (r *Registry) RegisterChecked(unit Unit, toBase, fromBase func(float64) float64, validate func(float64) error) error {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	key := normalizeUnitName(unit.Name)
+	if _, exists := r.units[key]; exists {
+		return &DuplicateUnitError{Unit: unit.Name}
+	}
+	r.units[key] = unitEntry{unit: unit, toBase: toBase, fromBase: fromBase, validate: validate}
+	r.generation++
+	return nil
+}
+
+// Alias registers one or more alternate names for an already-registered
+// canonical unit, so that e.g. Alias("m", "meter", "meters", "metre")
+// lets callers spell out "meters" wherever "m" is accepted. Matching is
+// case-insensitive. Aliasing an unknown canonical unit, or a name that
+// is already a unit or alias, returns an error.
+func (r *Registry) Alias(canonical string, names ...string) error {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	canonicalKey := normalizeUnitName(canonical)
+	if _, ok := r.units[canonicalKey]; !ok {
+		return &UnknownUnitError{Unit: canonical}
+	}
+	for _, name := range names {
+		key := normalizeUnitName(name)
+		if _, ok := r.units[key]; ok {
+			return &DuplicateUnitError{Unit: name}
+		}
+		if _, ok := r.aliases[key]; ok {
+			return &DuplicateUnitError{Unit: name}
+		}
+		r.aliases[key] = canonicalKey
+	}
+	return nil
+}
+
+// Canonical resolves name to the canonical unit name it refers to,
+// following aliases and normalizing case and surrounding whitespace.
+// The second return value is false if name is not a registered unit or
+// alias.
+func (r *Registry) Canonical(name string) (string, bool) {
+	r.mu.RLock()
+	defer r.mu.RUnlock()
+
+	entry, err := r.lookup(name)
+	if err != nil {
+		return "", false
+	}
+	return entry.unit.Name, true
+}
+
+// lookup assumes the caller already holds r.mu, for reading or writing.
+func (r *Registry) lookup(name string) (unitEntry, error) {
+	key := normalizeUnitName(name)
+	if canonical, ok := r.aliases[key]; ok {
+		key = canonical
+	}
+	entry, ok := r.units[key]
+	if !ok {
+		return unitEntry{}, &UnknownUnitError{Unit: name}
+	}
+	return entry, nil
+}
+
+// ConvertUnits converts v from the from unit to the to unit. Both names
+// are matched case-insensitively against registered units. An
+// UnknownUnitError is returned if either unit is not registered, and a
+// CrossDimensionError is returned if the two units do not share a
+// dimension.
+func (r *Registry) ConvertUnits(from, to string, v float64) (float64, error) {
+	r.mu.RLock()
+	defer r.mu.RUnlock()
+
+	fromEntry, err := r.lookup(from)
+	if err != nil {
+		return 0, err
+	}
+	toEntry, err := r.lookup(to)
+	if err != nil {
+		return 0, err
+	}
+	if fromEntry.unit.Dimension != toEntry.unit.Dimension {
+		return 0, &CrossDimensionError{From: fromEntry.unit, To: toEntry.unit}
+	}
+	if fromEntry.validate != nil {
+		if err := fromEntry.validate(v); err != nil {
+			return 0, err
+		}
+	}
+	result := toEntry.fromBase(fromEntry.toBase(v))
+	if err := checkFiniteResult(fromEntry, toEntry, v, result); err != nil {
+		return 0, err
+	}
+	return result, nil
+}
+
+// checkFiniteResult reports whether converting v from one unit to
+// another overflowed to +/-Inf, or underflowed a nonzero v to exactly
+// zero -- both mean result is a floating-point artifact of the
+// multiplication rather than a physically meaningful answer.
+//
+// The underflow check only applies when both units convert through
+// their base unit by pure scaling (toBase(0) and fromBase(0) are both
+// zero). Affine conversions like temperature legitimately cross zero
+// -- converting 273.15 K to Celsius is meant to produce exactly 0, not
+// an underflow -- so checking them would reject correct answers.
+func checkFiniteResult(fromEntry, toEntry unitEntry, v, result float64) error {
+	from, to := fromEntry.unit.Name, toEntry.unit.Name
+	if math.IsInf(result, 0) {
+		return &OverflowError{From: from, To: to, Value: v}
+	}
+	if v != 0 && result == 0 && fromEntry.toBase(0) == 0 && toEntry.fromBase(0) == 0 {
+		return &UnderflowError{From: from, To: to, Value: v}
+	}
+	return nil
+}
+
+// lookupCached is like lookup but memoizes its result in factorCache,
+// keyed by the registry's current generation. assumes the caller
+// already holds r.mu for reading, matching lookup.
+func (r *Registry) lookupCached(name string) (unitEntry, error) {
+	key := lookupCacheKey{generation: r.generation, name: normalizeUnitName(name)}
+	if cached, ok := r.factorCache.Load(key); ok {
+		return cached.(unitEntry), nil
+	}
+	entry, err := r.lookup(name)
+	if err != nil {
+		return unitEntry{}, err
+	}
+	r.factorCache.Store(key, entry)
+	return entry, nil
+}
+
+// ConvertDimensionCached is like ConvertUnits but resolves from and to
+// through lookupCached instead of lookup, so that repeatedly converting
+// the same pair of units (as ConvertDimension's callers tend to do)
+// skips re-deriving each unit's toBase/fromBase factors from the
+// registry's map on every call. Register bumps the generation counter
+// lookupCached keys on, so a unit registered after an entry was cached
+// is never masked by it -- the cache just grows a new generation of
+// entries rather than needing to evict the old one.
+func (r *Registry) ConvertDimensionCached(from, to string, v float64) (float64, error) {
+	r.mu.RLock()
+	defer r.mu.RUnlock()
+
+	fromEntry, err := r.lookupCached(from)
+	if err != nil {
+		return 0, err
+	}
+	toEntry, err := r.lookupCached(to)
+	if err != nil {
+		return 0, err
+	}
+	if fromEntry.unit.Dimension != toEntry.unit.Dimension {
+		return 0, &CrossDimensionError{From: fromEntry.unit, To: toEntry.unit}
+	}
+	if fromEntry.validate != nil {
+		if err := fromEntry.validate(v); err != nil {
+			return 0, err
+		}
+	}
+	result := toEntry.fromBase(fromEntry.toBase(v))
+	if err := checkFiniteResult(fromEntry, toEntry, v, result); err != nil {
+		return 0, err
+	}
+	return result, nil
+}
+
+// SameDimension reports whether unitA and unitB belong to the same
+// physical dimension, the same check ConvertUnits uses to decide
+// whether a conversion between them makes sense. Either name being
+// unregistered returns an UnknownUnitError.
+func (r *Registry) SameDimension(unitA, unitB string) (bool, error) {
+	r.mu.RLock()
+	defer r.mu.RUnlock()
+
+	a, err := r.lookup(unitA)
+	if err != nil {
+		return false, err
+	}
+	b, err := r.lookup(unitB)
+	if err != nil {
+		return false, err
+	}
+	return a.unit.Dimension == b.unit.Dimension, nil
+}
+
+func normalizeUnitName(name string) string {
+	return strings.ToLower(strings.TrimSpace(name))
+}
+
+// Units returns every unit registered directly (not as an alias), in no
+// particular order. It is primarily useful for listing what a Registry
+// supports, e.g. a CLI's "list" command.
+func (r *Registry) Units() []Unit {
+	r.mu.RLock()
+	defer r.mu.RUnlock()
+
+	units := make([]Unit, 0, len(r.units))
+	for _, entry := range r.units {
+		units = append(units, entry.unit)
+	}
+	return units
+}
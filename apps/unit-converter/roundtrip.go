@@ -0,0 +1,28 @@
+package converter
+
+import "math"
+
+// RoundTripError converts value with kind and converts the result back
+// with Reverse(kind), returning the absolute difference from the
+// original value. This is useful for documenting where floating-point
+// drift is significant, e.g. an affine temperature conversion like
+// "c2f" accumulates more error than a purely multiplicative one like
+// "m2ft". kind must be invertible, or RoundTripError returns whatever
+// error Reverse would have returned.
+func RoundTripError(kind string, value float64) (float64, error) {
+	reversed, err := Reverse(kind)
+	if err != nil {
+		return 0, err
+	}
+
+	converted, err := Convert(kind, value)
+	if err != nil {
+		return 0, err
+	}
+	back, err := Convert(reversed, converted)
+	if err != nil {
+		return 0, err
+	}
+
+	return math.Abs(back - value), nil
+}
@@ -0,0 +1,32 @@
+package converter
+
+import (
+	"errors"
+	"testing"
+)
+
+func TestRoundTripErrorLength(t *testing.T) {
+	diff, err := RoundTripError("m2ft", 5)
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if diff > 1e-6 {
+		t.Fatalf("round-trip error = %v, want something tiny", diff)
+	}
+}
+
+func TestRoundTripErrorAffineTemperature(t *testing.T) {
+	diff, err := RoundTripError("c2f", 100)
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if diff > 1e-6 {
+		t.Fatalf("round-trip error = %v, want something tiny", diff)
+	}
+}
+
+func TestRoundTripErrorNonInvertible(t *testing.T) {
+	if _, err := RoundTripError("parsecs2m", 1); !errors.Is(err, ErrUnsupportedConversion) {
+		t.Fatalf("expected ErrUnsupportedConversion, got %v", err)
+	}
+}
@@ -0,0 +1,69 @@
+// Package server exposes the converter package as a small HTTP
+// microservice, using only the standard library.
+package server
+
+import (
+	"encoding/json"
+	"net/http"
+	"strconv"
+
+	converter "github.com/richoandika/community-toolbox/apps/unit-converter"
+)
+
+// convertResponse is the JSON body returned by a successful
+// GET /convert.
+type convertResponse struct {
+	Result float64 `json:"result"`
+}
+
+// errorResponse is the JSON body returned for any 400 response.
+type errorResponse struct {
+	Error string `json:"error"`
+}
+
+// Handler returns an http.Handler serving:
+//
+//	GET /convert?kind=m2ft&value=1   -> {"result":3.28084}
+//	GET /conversions                 -> ["c2f","c2k",...]
+//
+// A missing or non-numeric value, or a kind Convert rejects, returns a
+// 400 with a JSON {"error":"..."} body.
+func Handler() http.Handler {
+	mux := http.NewServeMux()
+	mux.HandleFunc("/convert", handleConvert)
+	mux.HandleFunc("/conversions", handleConversions)
+	return mux
+}
+
+func handleConvert(w http.ResponseWriter, r *http.Request) {
+	kind := r.URL.Query().Get("kind")
+	valueStr := r.URL.Query().Get("value")
+
+	value, err := strconv.ParseFloat(valueStr, 64)
+	if err != nil {
+		writeError(w, http.StatusBadRequest, "value must be a number")
+		return
+	}
+
+	result, err := converter.Convert(kind, value)
+	if err != nil {
+		writeError(w, http.StatusBadRequest, err.Error())
+		return
+	}
+
+	writeJSON(w, http.StatusOK, convertResponse{Result: result})
+}
+
+func handleConversions(w http.ResponseWriter, r *http.Request) {
+	writeJSON(w, http.StatusOK, converter.ListConversions())
+}
+
+func writeJSON(w http.ResponseWriter, status int, body any) {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(status)
+	json.NewEncoder(w).Encode(body)
+}
+
+func writeError(w http.ResponseWriter, status int, message string) {
+	writeJSON(w, status, errorResponse{Error: message})
+}
@@ -0,0 +1,88 @@
+package server
+
+import (
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+)
+
+func TestHandleConvert(t *testing.T) {
+	req := httptest.NewRequest(http.MethodGet, "/convert?kind=m2ft&value=1", nil)
+	rec := httptest.NewRecorder()
+
+	Handler().ServeHTTP(rec, req)
+
+	if rec.Code != http.StatusOK {
+		t.Fatalf("status = %d, want %d", rec.Code, http.StatusOK)
+	}
+
+	var body convertResponse
+	if err := json.Unmarshal(rec.Body.Bytes(), &body); err != nil {
+		t.Fatalf("unexpected error decoding body: %v", err)
+	}
+	const want = 3.28084
+	if diff := body.Result - want; diff > 1e-5 || diff < -1e-5 {
+		t.Fatalf("result = %v, want %v", body.Result, want)
+	}
+}
+
+func TestHandleConvertBadKind(t *testing.T) {
+	req := httptest.NewRequest(http.MethodGet, "/convert?kind=bogus&value=1", nil)
+	rec := httptest.NewRecorder()
+
+	Handler().ServeHTTP(rec, req)
+
+	if rec.Code != http.StatusBadRequest {
+		t.Fatalf("status = %d, want %d", rec.Code, http.StatusBadRequest)
+	}
+
+	var body errorResponse
+	if err := json.Unmarshal(rec.Body.Bytes(), &body); err != nil {
+		t.Fatalf("unexpected error decoding body: %v", err)
+	}
+	if body.Error == "" {
+		t.Fatal("expected a non-empty error message")
+	}
+}
+
+func TestHandleConvertMissingValue(t *testing.T) {
+	req := httptest.NewRequest(http.MethodGet, "/convert?kind=m2ft", nil)
+	rec := httptest.NewRecorder()
+
+	Handler().ServeHTTP(rec, req)
+
+	if rec.Code != http.StatusBadRequest {
+		t.Fatalf("status = %d, want %d", rec.Code, http.StatusBadRequest)
+	}
+}
+
+func TestHandleConvertNonNumericValue(t *testing.T) {
+	req := httptest.NewRequest(http.MethodGet, "/convert?kind=m2ft&value=notanumber", nil)
+	rec := httptest.NewRecorder()
+
+	Handler().ServeHTTP(rec, req)
+
+	if rec.Code != http.StatusBadRequest {
+		t.Fatalf("status = %d, want %d", rec.Code, http.StatusBadRequest)
+	}
+}
+
+func TestHandleConversions(t *testing.T) {
+	req := httptest.NewRequest(http.MethodGet, "/conversions", nil)
+	rec := httptest.NewRecorder()
+
+	Handler().ServeHTTP(rec, req)
+
+	if rec.Code != http.StatusOK {
+		t.Fatalf("status = %d, want %d", rec.Code, http.StatusOK)
+	}
+
+	var keywords []string
+	if err := json.Unmarshal(rec.Body.Bytes(), &keywords); err != nil {
+		t.Fatalf("unexpected error decoding body: %v", err)
+	}
+	if len(keywords) == 0 {
+		t.Fatal("expected at least one keyword")
+	}
+}
@@ -0,0 +1,51 @@
+package converter
+
+import "strings"
+
+// siParsePrefixes is siPrefixes extended with the uppercase mega and
+// giga prefixes. mustRegisterSIPrefixes can't use these directly: the
+// registry normalizes names case-insensitively, so a registered "Mm"
+// would collide with the existing milli unit "mm". ParsePrefixed never
+// registers anything, so as plain string parsing it can tell the two
+// apart by case. Longer symbols ("da") are listed before their
+// single-character prefixes ("d") so they're tried first.
+var siParsePrefixes = []siPrefix{
+	{"da", 1e1},
+	{"k", 1e3},
+	{"h", 1e2},
+	{"d", 1e-1},
+	{"c", 1e-2},
+	{"m", 1e-3},
+	{"µ", 1e-6},
+	{"n", 1e-9},
+	{"M", 1e6},
+	{"G", 1e9},
+}
+
+// ParsePrefixed splits unit into a base unit symbol and the multiplier
+// its SI prefix represents, e.g. ParsePrefixed("km") returns ("m",
+// 1000) and ParsePrefixed("mg") returns ("g", 0.001). It tries each
+// known prefix before falling back to treating unit as a bare,
+// unprefixed unit -- this resolves the ambiguous case of a unit like
+// "m" (meter), which would otherwise also match the "m" (milli) prefix
+// with an empty base; stripping "m" leaves "", which isn't registered,
+// so the bare fallback returns ("m", 1) instead. Combined with
+// ConvertUnits, this lets callers support any SI-prefixed unit (Mm, µg,
+// ...) without registering every combination individually. An
+// UnknownUnitError is returned if unit is neither a registered unit nor
+// a recognized prefix over one.
+func ParsePrefixed(unit string) (baseUnit string, factor float64, err error) {
+	for _, p := range siParsePrefixes {
+		base := strings.TrimPrefix(unit, p.Symbol)
+		if base == unit || base == "" {
+			continue
+		}
+		if _, ok := defaultRegistry.Canonical(base); ok {
+			return base, p.Factor, nil
+		}
+	}
+	if _, ok := defaultRegistry.Canonical(unit); ok {
+		return unit, 1, nil
+	}
+	return "", 0, &UnknownUnitError{Unit: unit}
+}
@@ -0,0 +1,99 @@
+package converter
+
+import (
+	"bufio"
+	"compress/gzip"
+	"context"
+	"fmt"
+	"io"
+	"strconv"
+)
+
+// ConvertStream applies the "from2to" keyword kind to every line of r,
+// one number per line, writing one converted result per line to w. It
+// never buffers the whole input in memory, so it is suited to large
+// files that shouldn't be loaded all at once. Blank lines are skipped.
+// A line that does not parse as a float64 aborts the stream with an
+// error naming the offending line number; any partial output already
+// written to w is not rolled back.
+func ConvertStream(kind string, r io.Reader, w io.Writer) error {
+	scanner := bufio.NewScanner(r)
+	lineNum := 0
+	for scanner.Scan() {
+		lineNum++
+		line := scanner.Text()
+		if line == "" {
+			continue
+		}
+
+		value, err := strconv.ParseFloat(line, 64)
+		if err != nil {
+			return fmt.Errorf("converter: line %d: %q is not a number", lineNum, line)
+		}
+
+		result, err := Convert(kind, value)
+		if err != nil {
+			return fmt.Errorf("converter: line %d: %w", lineNum, err)
+		}
+
+		if _, err := fmt.Fprintln(w, result); err != nil {
+			return err
+		}
+	}
+	return scanner.Err()
+}
+
+// ConvertStreamContext is like ConvertStream but aborts early with
+// ctx.Err() if ctx is canceled before the stream finishes. The context
+// is checked once per line, so cancellation takes effect on the next
+// line boundary rather than mid-line; any output already written to w
+// before cancellation is not rolled back.
+func ConvertStreamContext(ctx context.Context, kind string, r io.Reader, w io.Writer) error {
+	scanner := bufio.NewScanner(r)
+	lineNum := 0
+	for scanner.Scan() {
+		if err := ctx.Err(); err != nil {
+			return err
+		}
+
+		lineNum++
+		line := scanner.Text()
+		if line == "" {
+			continue
+		}
+
+		value, err := strconv.ParseFloat(line, 64)
+		if err != nil {
+			return fmt.Errorf("converter: line %d: %q is not a number", lineNum, line)
+		}
+
+		result, err := Convert(kind, value)
+		if err != nil {
+			return fmt.Errorf("converter: line %d: %w", lineNum, err)
+		}
+
+		if _, err := fmt.Fprintln(w, result); err != nil {
+			return err
+		}
+	}
+	return scanner.Err()
+}
+
+// ConvertStreamGzip is like ConvertStream but transparently
+// gzip-decompresses r on the way in and gzip-compresses the output
+// written to w, for input files that arrive compressed. A truncated or
+// corrupt gzip stream in r returns a clear error rather than a partial
+// or garbled result.
+func ConvertStreamGzip(kind string, r io.Reader, w io.Writer) error {
+	gzipReader, err := gzip.NewReader(r)
+	if err != nil {
+		return fmt.Errorf("converter: reading gzip input: %w", err)
+	}
+	defer gzipReader.Close()
+
+	gzipWriter := gzip.NewWriter(w)
+	if err := ConvertStream(kind, gzipReader, gzipWriter); err != nil {
+		return err
+	}
+	return gzipWriter.Close()
+}
@@ -0,0 +1,60 @@
+package converter
+
+import (
+	"math"
+	"sort"
+)
+
+// rangeDistance returns how far abs falls outside [1, 1000]: 0 if abs
+// is already in range, otherwise the distance to whichever edge is
+// closer.
+func rangeDistance(abs float64) float64 {
+	switch {
+	case abs < 1:
+		return 1 - abs
+	case abs > 1000:
+		return abs - 1000
+	default:
+		return 0
+	}
+}
+
+// SuggestUnit picks whichever unit registered for dimension renders
+// baseValue (expressed in the dimension's Humanize base unit) closest
+// to the 1-1000 range, e.g. a length of 0.0005 m suggests "mm" with
+// value 0.5. Ties are broken by unit name so the result is
+// deterministic. Only the dimensions humanizeScales covers are
+// supported; any other dimension returns an *UnknownDimensionError.
+func SuggestUnit(dimension string, baseValue float64) (unit string, value float64, err error) {
+	scale, ok := humanizeScales[Dimension(dimension)]
+	if !ok {
+		return "", 0, &UnknownDimensionError{Dimension: dimension}
+	}
+
+	var names []string
+	for _, u := range defaultRegistry.Units() {
+		if u.Dimension == Dimension(dimension) {
+			names = append(names, u.Name)
+		}
+	}
+	sort.Strings(names)
+
+	bestDistance := math.Inf(1)
+	for _, name := range names {
+		converted, err := defaultRegistry.ConvertUnits(scale.BaseUnit, name, baseValue)
+		if err != nil {
+			return "", 0, err
+		}
+		abs := converted
+		if abs < 0 {
+			abs = -abs
+		}
+		if distance := rangeDistance(abs); distance < bestDistance {
+			bestDistance = distance
+			unit = name
+			value = converted
+		}
+	}
+
+	return unit, value, nil
+}
@@ -0,0 +1,57 @@
+package converter
+
+import (
+	"errors"
+	"testing"
+)
+
+func TestSuggestUnitLengthSmall(t *testing.T) {
+	unit, value, err := SuggestUnit("length", 0.0005)
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if unit != "µm" {
+		t.Fatalf("unit = %q, want %q", unit, "µm")
+	}
+	assertClose(t, value, 500, 1e-9, 1e-9)
+}
+
+func TestSuggestUnitLengthLarge(t *testing.T) {
+	unit, value, err := SuggestUnit("length", 1500)
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if unit != "dam" {
+		t.Fatalf("unit = %q, want %q", unit, "dam")
+	}
+	assertClose(t, value, 150, 1e-9, 1e-9)
+}
+
+func TestSuggestUnitMassSmall(t *testing.T) {
+	unit, value, err := SuggestUnit("mass", 0.0000025)
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if unit != "mg" {
+		t.Fatalf("unit = %q, want %q", unit, "mg")
+	}
+	assertClose(t, value, 2.5, 1e-9, 1e-9)
+}
+
+func TestSuggestUnitMassLarge(t *testing.T) {
+	unit, value, err := SuggestUnit("mass", 2500)
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if unit != "t" {
+		t.Fatalf("unit = %q, want %q", unit, "t")
+	}
+	assertClose(t, value, 2.5, 1e-9, 1e-9)
+}
+
+func TestSuggestUnitUnknownDimension(t *testing.T) {
+	var target *UnknownDimensionError
+	if _, _, err := SuggestUnit("bogus", 1); !errors.As(err, &target) {
+		t.Fatalf("expected *UnknownDimensionError, got %v", err)
+	}
+}
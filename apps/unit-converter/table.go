@@ -0,0 +1,75 @@
+package converter
+
+import (
+	"fmt"
+	"math"
+	"strings"
+)
+
+// Table returns [input, output] pairs for kind, stepping from start to
+// end (inclusive) by step, e.g. a meters-to-feet lookup table for a
+// reference chart. step must be positive, and start must not be
+// greater than end.
+func Table(kind string, start, end, step float64) ([][2]float64, error) {
+	if step <= 0 {
+		return nil, fmt.Errorf("converter: step must be positive, got %g", step)
+	}
+	if start > end {
+		return nil, fmt.Errorf("converter: start (%g) must not be greater than end (%g)", start, end)
+	}
+
+	// Accumulating v by adding step repeatedly drifts from the exact
+	// multiples of step as floating-point error builds up, so the
+	// upper bound is compared with a small epsilon rather than v<=end.
+	const epsilon = 1e-9
+
+	var rows [][2]float64
+	for v := start; v <= end+epsilon; v += step {
+		out, err := Convert(kind, v)
+		if err != nil {
+			return nil, err
+		}
+		rows = append(rows, [2]float64{v, out})
+	}
+	return rows, nil
+}
+
+// TableRounded is like Table but rounds each output value to decimals
+// decimal places using the same half-up rounding ConvertRound uses,
+// e.g. a meters-to-feet reference chart readable to 2 decimals instead
+// of full floating-point precision. decimals must not be negative.
+func TableRounded(kind string, start, end, step float64, decimals int) ([][2]float64, error) {
+	if decimals < 0 {
+		return nil, fmt.Errorf("converter: decimals must not be negative, got %d", decimals)
+	}
+	rows, err := Table(kind, start, end, step)
+	if err != nil {
+		return nil, err
+	}
+
+	scale := math.Pow(10, float64(decimals))
+	for i, row := range rows {
+		rows[i][1] = math.Round(row[1]*scale) / scale
+	}
+	return rows, nil
+}
+
+// TableCSV is like Table, but renders the result as CSV with a header
+// row naming the source and target units.
+func TableCSV(kind string, start, end, step float64) (string, error) {
+	rows, err := Table(kind, start, end, step)
+	if err != nil {
+		return "", err
+	}
+	from, to, err := splitKeyword(kind)
+	if err != nil {
+		return "", err
+	}
+
+	var sb strings.Builder
+	fmt.Fprintf(&sb, "%s,%s\n", from, to)
+	for _, row := range rows {
+		fmt.Fprintf(&sb, "%s,%s\n", formatValue(row[0]), formatValue(row[1]))
+	}
+	return sb.String(), nil
+}
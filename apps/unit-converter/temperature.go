@@ -0,0 +1,113 @@
+package converter
+
+import (
+	"fmt"
+	"strconv"
+	"strings"
+)
+
+// affineTransform represents the linear relationship y = A*x + B used to
+// move a temperature from its native scale to Kelvin, the dimension's
+// base unit. Modeling temperature as affine rather than purely
+// multiplicative lets every scale, including inverted ones like
+// Delisle, round-trip through a single base unit.
+type affineTransform struct {
+	A, B float64
+}
+
+func (t affineTransform) apply(x float64) float64  { return t.A*x + t.B }
+func (t affineTransform) invert(y float64) float64 { return (y - t.B) / t.A }
+
+// absoluteZero returns the value, expressed in the transform's own
+// scale, that corresponds to absolute zero (0 Kelvin).
+func (t affineTransform) absoluteZero() float64 { return t.invert(0) }
+
+// registerTemperatureUnit registers a temperature unit that converts to
+// and from Kelvin via toKelvin, and rejects values below absolute zero
+// in the unit's own scale. Scales that run in the opposite direction to
+// Kelvin, such as Delisle (A < 0), are rejected above their absolute
+// zero instead.
+func registerTemperatureUnit(r *Registry, name string, toKelvin affineTransform) {
+	absZero := toKelvin.absoluteZero()
+	inverted := toKelvin.A < 0
+
+	validate := func(v float64) error {
+		belowAbsoluteZero := v < absZero
+		if inverted {
+			belowAbsoluteZero = v > absZero
+		}
+		if belowAbsoluteZero {
+			return &ErrBelowAbsoluteZero{Unit: name, Value: v}
+		}
+		return nil
+	}
+
+	if err := r.RegisterChecked(
+		Unit{Name: name, Dimension: DimensionTemperature},
+		toKelvin.apply,
+		toKelvin.invert,
+		validate,
+	); err != nil {
+		panic(err)
+	}
+}
+
+// temperatureUnitLetters maps the single-letter unit abbreviations
+// ParseTemperature accepts to the canonical registry unit name that
+// carries the actual conversion, e.g. "f" (from "72°F") to "f" itself.
+// Only Celsius, Fahrenheit, and Kelvin have a conventional single-letter
+// form; the other registered scales (Rankine, Réaumur, Delisle) don't,
+// so ParseTemperature doesn't try to guess at them.
+var temperatureUnitLetters = map[string]string{
+	"c": "c",
+	"f": "f",
+	"k": "k",
+}
+
+// InvalidTemperatureStringError is returned by ParseTemperature when s
+// isn't a number optionally followed by a degree symbol and a unit
+// letter.
+type InvalidTemperatureStringError struct {
+	Input string
+}
+
+func (e *InvalidTemperatureStringError) Error() string {
+	return fmt.Sprintf("converter: %q is not a valid temperature (expected a number, an optional \"°\", and a C/F/K unit letter)", e.Input)
+}
+
+// ParseTemperature parses a temperature string like "72°F", "22 °C",
+// or "295 K" -- a number, an optional degree symbol, and a unit letter,
+// with any amount of whitespace between them -- and returns its value
+// in Celsius. Matching is case-insensitive. A missing or unrecognized
+// unit letter, or a non-numeric value, returns an
+// *InvalidTemperatureStringError.
+func ParseTemperature(s string) (celsius float64, err error) {
+	trimmed := strings.TrimSpace(strings.ReplaceAll(s, "°", " "))
+
+	i := len(trimmed)
+	for i > 0 && isASCIILetter(trimmed[i-1]) {
+		i--
+	}
+	numPart := strings.TrimSpace(trimmed[:i])
+	unitPart := strings.ToLower(strings.TrimSpace(trimmed[i:]))
+
+	if numPart == "" || unitPart == "" {
+		return 0, &InvalidTemperatureStringError{Input: s}
+	}
+	value, parseErr := strconv.ParseFloat(numPart, 64)
+	if parseErr != nil {
+		return 0, &InvalidTemperatureStringError{Input: s}
+	}
+	unit, ok := temperatureUnitLetters[unitPart]
+	if !ok {
+		return 0, &InvalidTemperatureStringError{Input: s}
+	}
+
+	return ConvertUnits(unit, "c", value)
+}
+
+// isASCIILetter reports whether b is an ASCII letter, the only
+// characters ParseTemperature expects in a unit suffix.
+func isASCIILetter(b byte) bool {
+	return (b >= 'a' && b <= 'z') || (b >= 'A' && b <= 'Z')
+}
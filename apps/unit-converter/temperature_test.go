@@ -0,0 +1,45 @@
+package converter
+
+import (
+	"errors"
+	"testing"
+)
+
+func TestParseTemperature(t *testing.T) {
+	tests := []struct {
+		input string
+		want  float64
+	}{
+		{"72°F", 22.22222222222222},
+		{"22 °C", 22},
+		{"295 K", 21.850000000000023},
+	}
+	for _, tc := range tests {
+		got, err := ParseTemperature(tc.input)
+		if err != nil {
+			t.Fatalf("ParseTemperature(%q) returned error: %v", tc.input, err)
+		}
+		assertClose(t, got, tc.want, 1e-6, 1e-6)
+	}
+}
+
+func TestParseTemperatureMalformed(t *testing.T) {
+	var target *InvalidTemperatureStringError
+	if _, err := ParseTemperature("not a temperature"); !errors.As(err, &target) {
+		t.Fatalf("expected *InvalidTemperatureStringError, got %v", err)
+	}
+}
+
+func TestParseTemperatureMissingUnit(t *testing.T) {
+	var target *InvalidTemperatureStringError
+	if _, err := ParseTemperature("72"); !errors.As(err, &target) {
+		t.Fatalf("expected *InvalidTemperatureStringError, got %v", err)
+	}
+}
+
+func TestParseTemperatureUnknownUnit(t *testing.T) {
+	var target *InvalidTemperatureStringError
+	if _, err := ParseTemperature("72°Z"); !errors.As(err, &target) {
+		t.Fatalf("expected *InvalidTemperatureStringError, got %v", err)
+	}
+}
@@ -0,0 +1,38 @@
+package converter
+
+import (
+	"fmt"
+	"strconv"
+	"strings"
+	"unicode"
+)
+
+// ConvertTempString parses input as a number with a trailing unit
+// suffix, e.g. "98.6F", "37C", or "310K", and converts it to
+// targetUnit. Surrounding whitespace is trimmed and the suffix is
+// matched case-insensitively via CanonicalUnit, so "37c" and "37C"
+// both resolve to Celsius. It is meant for parsing sensor logs where
+// the unit travels with the value instead of in a separate field.
+func ConvertTempString(input, targetUnit string) (float64, error) {
+	s := strings.TrimSpace(input)
+
+	i := len(s)
+	for i > 0 && unicode.IsLetter(rune(s[i-1])) {
+		i--
+	}
+	if i == len(s) {
+		return 0, fmt.Errorf("converter: %q is missing a unit suffix", input)
+	}
+
+	numPart, suffix := s[:i], s[i:]
+	value, err := strconv.ParseFloat(numPart, 64)
+	if err != nil {
+		return 0, fmt.Errorf("converter: %q is not a valid number", numPart)
+	}
+
+	fromUnit, ok := CanonicalUnit(suffix)
+	if !ok {
+		return 0, &UnknownUnitError{Unit: suffix}
+	}
+	return ConvertUnits(fromUnit, targetUnit, value)
+}
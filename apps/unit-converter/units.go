@@ -0,0 +1,277 @@
+package converter
+
+// defaultRegistry holds the units built into the package. Third-party
+// code can register additional units on it via Register, or build an
+// independent Registry with NewRegistry.
+var defaultRegistry = NewRegistry()
+
+func init() {
+	mustRegister(Unit{Name: "m", Dimension: DimensionLength},
+		func(v float64) float64 { return v },
+		func(v float64) float64 { return v })
+	mustRegister(Unit{Name: "ft", Dimension: DimensionLength},
+		func(v float64) float64 { return v * feetToMetersFactor },
+		func(v float64) float64 { return v * metersToFeetFactor })
+	mustRegister(Unit{Name: "in", Dimension: DimensionLength},
+		func(v float64) float64 { return v * inToMetersFactor },
+		func(v float64) float64 { return v * metersToInFactor })
+	mustAlias("m", "meter", "meters", "metre", "metres")
+	mustAlias("ft", "foot", "feet")
+	mustAlias("in", "inch", "inches")
+	mustRegisterSIPrefixes(defaultRegistry, "m", DimensionLength, siPrefixes)
+
+	registerTemperatureUnit(defaultRegistry, "k", affineTransform{A: 1, B: 0})
+	registerTemperatureUnit(defaultRegistry, "c", affineTransform{A: 1, B: 273.15})
+	registerTemperatureUnit(defaultRegistry, "f", affineTransform{A: 5.0 / 9.0, B: 255.3722222222222})
+	registerTemperatureUnit(defaultRegistry, "r", affineTransform{A: 5.0 / 9.0, B: 0})
+	registerTemperatureUnit(defaultRegistry, "re", affineTransform{A: 5.0 / 4.0, B: 273.15})
+	registerTemperatureUnit(defaultRegistry, "de", affineTransform{A: -2.0 / 3.0, B: 373.15})
+	mustAlias("k", "kelvin")
+	mustAlias("c", "celsius", "°c")
+	mustAlias("f", "fahrenheit", "°f")
+	mustAlias("r", "rankine", "°r")
+	mustAlias("re", "reaumur", "réaumur", "°re")
+	mustAlias("de", "delisle", "°de")
+
+	mustRegister(Unit{Name: "kg", Dimension: DimensionMass},
+		func(v float64) float64 { return v },
+		func(v float64) float64 { return v })
+	mustRegister(Unit{Name: "g", Dimension: DimensionMass},
+		func(v float64) float64 { return v * gToKgFactor },
+		func(v float64) float64 { return v * kgToGFactor })
+	mustRegister(Unit{Name: "lb", Dimension: DimensionMass},
+		func(v float64) float64 { return v * lbToKgFactor },
+		func(v float64) float64 { return v * kgToLbFactor })
+	mustRegister(Unit{Name: "oz", Dimension: DimensionMass},
+		func(v float64) float64 { return v * ozToKgFactor },
+		func(v float64) float64 { return v * kgToOzFactor })
+	mustRegister(Unit{Name: "mg", Dimension: DimensionMass},
+		func(v float64) float64 { return v * mgToKgFactor },
+		func(v float64) float64 { return v * kgToMgFactor })
+	mustRegister(Unit{Name: "t", Dimension: DimensionMass},
+		func(v float64) float64 { return v * tToKgFactor },
+		func(v float64) float64 { return v * kgToTFactor })
+	mustAlias("kg", "kilogram", "kilograms")
+	mustAlias("g", "gram", "grams")
+	mustAlias("lb", "pound", "pounds")
+	mustAlias("oz", "ounce", "ounces")
+	mustAlias("mg", "milligram", "milligrams")
+	mustAlias("t", "tonne", "tonnes", "metricton", "metrictons")
+
+	mustRegister(Unit{Name: "l", Dimension: DimensionVolume},
+		func(v float64) float64 { return v },
+		func(v float64) float64 { return v })
+	mustRegisterSIPrefixes(defaultRegistry, "l", DimensionVolume, siPrefixes)
+	mustRegister(Unit{Name: "gal", Dimension: DimensionVolume},
+		func(v float64) float64 { return v * usGalToLitersFactor },
+		func(v float64) float64 { return v * litersToUSGalFactor })
+	mustRegister(Unit{Name: "floz", Dimension: DimensionVolume},
+		func(v float64) float64 { return v * usFlozToLitersFactor },
+		func(v float64) float64 { return v * litersToUSFlozFactor })
+	mustAlias("l", "liter", "liters", "litre", "litres")
+	mustAlias("gal", "gallon", "gallons")
+	mustAlias("floz", "fluidounce", "fluidounces")
+	mustRegister(Unit{Name: "impgal", Dimension: DimensionVolume},
+		func(v float64) float64 { return v * impGalToLitersFactor },
+		func(v float64) float64 { return v * litersToImpGalFactor })
+	mustRegister(Unit{Name: "impfloz", Dimension: DimensionVolume},
+		func(v float64) float64 { return v * impFlozToLitersFactor },
+		func(v float64) float64 { return v * litersToImpFlozFactor })
+	mustAlias("impgal", "imperialgallon", "imperialgallons")
+	mustAlias("impfloz", "imperialfluidounce", "imperialfluidounces")
+	mustRegisterFactorUnits(defaultRegistry, DimensionVolume, cookingVolumeUnits)
+	mustAlias("cup", "cups")
+	mustAlias("tbsp", "tablespoon", "tablespoons")
+	mustAlias("tsp", "teaspoon", "teaspoons")
+
+	mustRegister(Unit{Name: "b", Dimension: DimensionData},
+		func(v float64) float64 { return v },
+		func(v float64) float64 { return v })
+	mustRegisterFactorUnits(defaultRegistry, DimensionData, decimalDataUnits)
+	mustRegisterFactorUnits(defaultRegistry, DimensionData, binaryDataUnits)
+	mustAlias("b", "byte", "bytes")
+
+	mustRegister(Unit{Name: "s", Dimension: DimensionTime},
+		func(v float64) float64 { return v },
+		func(v float64) float64 { return v })
+	mustRegisterFactorUnits(defaultRegistry, DimensionTime, timeUnits)
+	mustAlias("s", "second", "seconds")
+	mustAlias("min", "minute", "minutes")
+	mustAlias("h", "hour", "hours")
+	mustAlias("day", "days")
+
+	mustRegister(Unit{Name: "rad", Dimension: DimensionAngle},
+		func(v float64) float64 { return v },
+		func(v float64) float64 { return v })
+	mustRegister(Unit{Name: "deg", Dimension: DimensionAngle},
+		func(v float64) float64 { return v * degToRadFactor },
+		func(v float64) float64 { return v * radToDegFactor })
+	mustRegister(Unit{Name: "grad", Dimension: DimensionAngle},
+		func(v float64) float64 { return v * gradToRadFactor },
+		func(v float64) float64 { return v * radToGradFactor })
+	mustAlias("rad", "radian", "radians")
+	mustAlias("deg", "degree", "degrees")
+	mustAlias("grad", "gradian", "gradians")
+
+	mustRegister(Unit{Name: "pa", Dimension: DimensionPressure},
+		func(v float64) float64 { return v },
+		func(v float64) float64 { return v })
+	mustRegisterFactorUnits(defaultRegistry, DimensionPressure, pressureUnits)
+	mustAlias("pa", "pascal", "pascals")
+
+	mustRegister(Unit{Name: "j", Dimension: DimensionEnergy},
+		func(v float64) float64 { return v },
+		func(v float64) float64 { return v })
+	mustRegisterFactorUnits(defaultRegistry, DimensionEnergy, energyUnits)
+	mustAlias("j", "joule", "joules")
+	mustAlias("cal", "calorie", "calories")
+	mustAlias("kwh", "kilowatthour", "kilowatthours")
+	mustAlias("kcal", "kilocalorie", "kilocalories")
+
+	registerFuelEconomyUnits(defaultRegistry)
+
+	mustRegister(Unit{Name: "ms", Dimension: DimensionSpeed},
+		func(v float64) float64 { return v },
+		func(v float64) float64 { return v })
+	mustRegister(Unit{Name: "kmh", Dimension: DimensionSpeed},
+		func(v float64) float64 { return v * KMHToMSFactor },
+		func(v float64) float64 { return v * MSToKMHFactor })
+	mustRegister(Unit{Name: "mph", Dimension: DimensionSpeed},
+		func(v float64) float64 { return v * MPHToMSFactor },
+		func(v float64) float64 { return v * MSToMPHFactor })
+
+	mustRegister(Unit{Name: "n", Dimension: DimensionForce},
+		func(v float64) float64 { return v },
+		func(v float64) float64 { return v })
+	mustRegister(Unit{Name: "lbf", Dimension: DimensionForce},
+		func(v float64) float64 { return v * lbfToNFactor },
+		func(v float64) float64 { return v * nToLbfFactor })
+	mustAlias("n", "newton", "newtons")
+	mustAlias("lbf", "poundforce", "pound-force")
+
+	mustLoadEmbeddedConversions()
+}
+
+func mustRegister(unit Unit, toBase, fromBase func(float64) float64) {
+	if err := defaultRegistry.Register(unit, toBase, fromBase); err != nil {
+		panic(err)
+	}
+}
+
+func mustAlias(canonical string, names ...string) {
+	if err := defaultRegistry.Alias(canonical, names...); err != nil {
+		panic(err)
+	}
+}
+
+// siPrefix describes one SI prefix that can be layered on top of a base
+// unit, e.g. "k" turns "m" into "km" with a factor of 1e3.
+type siPrefix struct {
+	Symbol string
+	Factor float64
+}
+
+// siPrefixes covers the SI prefixes commonly seen on length and data
+// units (km, cm, mm, µm, ...).
+var siPrefixes = []siPrefix{
+	{"k", 1e3},
+	{"h", 1e2},
+	{"da", 1e1},
+	{"d", 1e-1},
+	{"c", 1e-2},
+	{"m", 1e-3},
+	{"µ", 1e-6},
+	{"n", 1e-9},
+}
+
+// mustRegisterSIPrefixes registers, for each siPrefix, a unit named
+// prefix+baseName whose conversion to/from the dimension's base is a
+// straight multiplication by the prefix factor. baseName must already
+// be registered as the dimension's base unit (toBase/fromBase are the
+// identity function).
+func mustRegisterSIPrefixes(r *Registry, baseName string, dim Dimension, prefixes []siPrefix) {
+	for _, p := range prefixes {
+		factor := p.Factor
+		unit := Unit{Name: p.Symbol + baseName, Dimension: dim}
+		if err := r.Register(unit,
+			func(v float64) float64 { return v * factor },
+			func(v float64) float64 { return v / factor },
+		); err != nil {
+			panic(err)
+		}
+	}
+}
+
+// factorUnit is a unit whose name is unrelated to the dimension's base
+// unit name (unlike siPrefix, which always prepends a symbol), but
+// which still converts to/from the base by a straight multiplication,
+// e.g. "kib" is 1024 of "b".
+type factorUnit struct {
+	Name   string
+	Factor float64
+}
+
+// decimalDataUnits are the SI (base-1000) data-storage units: 1 kB is
+// exactly 1000 B. Contrast with binaryDataUnits, which are base-1024.
+// Both dimensions share baseUnit "b" (bytes), so e.g. "mb2mib" converts
+// correctly through it, deliberately, rather than treating 1 MB and
+// 1 MiB as interchangeable.
+var decimalDataUnits = []factorUnit{
+	{"kb", 1e3},
+	{"mb", 1e6},
+	{"gb", 1e9},
+}
+
+// binaryDataUnits are the IEC (base-1024) data-storage units.
+var binaryDataUnits = []factorUnit{
+	{"kib", 1 << 10},
+	{"mib", 1 << 20},
+	{"gib", 1 << 30},
+}
+
+// timeUnits are calendar-agnostic: a day is always 86400 seconds, with
+// no leap seconds or DST adjustments.
+var timeUnits = []factorUnit{
+	{"min", 60},
+	{"h", 3600},
+	{"day", 86400},
+}
+
+// pressureUnits use the standard atmosphere as defined by the 1954
+// 10th Conférence Générale des Poids et Mesures (101325 Pa exactly).
+var pressureUnits = []factorUnit{
+	{"bar", 1e5},
+	{"atm", 101325},
+	{"psi", 6894.757293},
+}
+
+// cookingVolumeUnits are US customary cooking measures, not metric
+// ones (a metric cup is 250 mL, not 236.588 mL).
+var cookingVolumeUnits = []factorUnit{
+	{"cup", 0.236588},
+	{"tbsp", 0.0147868},
+	{"tsp", 0.00492892},
+}
+
+// energyUnits use the thermochemical calorie (4.184 J exactly).
+var energyUnits = []factorUnit{
+	{"cal", 4.184},
+	{"kwh", 3600000},
+	{"kcal", 4184},
+}
+
+// mustRegisterFactorUnits registers each factorUnit as a unit of dim
+// that converts to/from the dimension's base unit by a straight
+// multiplication.
+func mustRegisterFactorUnits(r *Registry, dim Dimension, units []factorUnit) {
+	for _, u := range units {
+		factor := u.Factor
+		unit := Unit{Name: u.Name, Dimension: dim}
+		if err := r.Register(unit,
+			func(v float64) float64 { return v * factor },
+			func(v float64) float64 { return v / factor },
+		); err != nil {
+			panic(err)
+		}
+	}
+}
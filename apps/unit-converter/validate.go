@@ -0,0 +1,52 @@
+package converter
+
+import "strings"
+
+// nonNegativeDimensions lists dimensions whose physical quantity can
+// never be negative: you can't have negative mass, length, or volume.
+var nonNegativeDimensions = map[Dimension]bool{
+	DimensionMass:   true,
+	DimensionLength: true,
+	DimensionVolume: true,
+}
+
+// ValidateInput reports whether value is within the physically
+// meaningful domain for kind's source unit, without performing the
+// conversion. Mass, length, and volume must be non-negative; Kelvin
+// must be at or above 0 K. Units without a known floor, such as
+// Celsius, which permits negative values, always pass. ValidateInput
+// returns an UnknownUnitError if kind's source unit isn't registered.
+func ValidateInput(kind string, value float64) error {
+	from, _, err := splitKeyword(kind)
+	if err != nil {
+		return err
+	}
+	unitName, ok := CanonicalUnit(from)
+	if !ok {
+		return &UnknownUnitError{Unit: from}
+	}
+
+	if unitName == "k" && value < 0 {
+		return &DomainError{Unit: unitName, Value: value}
+	}
+
+	for _, u := range Units() {
+		if u.Name == unitName && nonNegativeDimensions[u.Dimension] && value < 0 {
+			return &DomainError{Unit: unitName, Value: value}
+		}
+	}
+	return nil
+}
+
+// ConvertStrict is like Convert, but first rejects values outside the
+// physically meaningful domain for kind's source unit via
+// ValidateInput. Convert itself stays lenient, since some callers
+// intentionally push values (like a negative Celsius reading) that
+// ValidateInput would reject for other units sharing the dimension.
+func ConvertStrict(kind string, value float64) (float64, error) {
+	if err := ValidateInput(kind, value); err != nil {
+		reason := strings.TrimPrefix(err.Error(), "converter: ")
+		return 0, &ConversionError{Kind: kind, Reason: reason, cause: err}
+	}
+	return Convert(kind, value)
+}
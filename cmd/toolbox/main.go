@@ -0,0 +1,157 @@
+// Command toolbox is a single entry point that dispatches to the
+// community-toolbox's individual apps as subcommands, e.g.
+//
+//	toolbox convert 5 m to ft
+//	toolbox roman 42
+//	toolbox base 255 10 16
+//
+// Running toolbox has grown clunky with each app, so this wraps them
+// under one binary instead of requiring a separate invocation per app.
+package main
+
+import (
+	"fmt"
+	"io"
+	"os"
+	"strconv"
+
+	baseconverter "github.com/richoandika/community-toolbox/apps/base-converter"
+	roman "github.com/richoandika/community-toolbox/apps/roman-numeral"
+	converter "github.com/richoandika/community-toolbox/apps/unit-converter"
+)
+
+// Exit codes let scripts branch on why toolbox failed.
+const (
+	exitOK = iota
+	exitUsage
+)
+
+func main() {
+	os.Exit(Run(os.Args[1:], os.Stdout, os.Stderr))
+}
+
+// subcommands maps each subcommand name to the function that runs it.
+// Keeping this as data, rather than a switch, means printUsage can
+// list exactly the set Run actually dispatches to.
+var subcommands = map[string]func(args []string, out, errW io.Writer) int{
+	"convert": runConvert,
+	"roman":   runRoman,
+	"base":    runBase,
+}
+
+// Run dispatches args[0] to the matching subcommand, passing the rest
+// of args to it, and returns the process exit code. With no arguments,
+// or "-h"/"--help"/"help", it prints usage to out and returns exitOK.
+// An unrecognized subcommand prints usage to errW and returns
+// exitUsage.
+func Run(args []string, out, errW io.Writer) int {
+	if len(args) == 0 {
+		printUsage(out)
+		return exitOK
+	}
+
+	switch args[0] {
+	case "-h", "--help", "help":
+		printUsage(out)
+		return exitOK
+	}
+
+	cmd, ok := subcommands[args[0]]
+	if !ok {
+		fmt.Fprintf(errW, "toolbox: unknown subcommand %q\n", args[0])
+		printUsage(errW)
+		return exitUsage
+	}
+	return cmd(args[1:], out, errW)
+}
+
+// printUsage lists every subcommand Run knows how to dispatch to.
+func printUsage(w io.Writer) {
+	fmt.Fprintln(w, "usage: toolbox <subcommand> [args]")
+	fmt.Fprintln(w, "subcommands:")
+	fmt.Fprintln(w, "  convert <value> <unit> to <unit>   convert a value between units")
+	fmt.Fprintln(w, "  roman <value>                       convert between an integer and Roman numerals")
+	fmt.Fprintln(w, "  base <value> <from> <to>            convert a number between bases")
+}
+
+// runConvert dispatches to the unit-converter app, parsing args as a
+// single "<value> <unit> to <unit>" expression.
+func runConvert(args []string, out, errW io.Writer) int {
+	expr := joinArgs(args)
+	result, err := converter.ParseAndConvert(expr)
+	if err != nil {
+		fmt.Fprintf(errW, "toolbox convert: %v\n", err)
+		return exitUsage
+	}
+	fmt.Fprintln(out, result)
+	return exitOK
+}
+
+// runRoman dispatches to the roman-numeral app: an integer argument
+// converts to Roman numerals, and anything else is parsed as Roman
+// numerals and converted back to an integer.
+func runRoman(args []string, out, errW io.Writer) int {
+	if len(args) != 1 {
+		fmt.Fprintln(errW, "toolbox roman: expected exactly one argument")
+		return exitUsage
+	}
+
+	if n, err := strconv.Atoi(args[0]); err == nil {
+		result, err := roman.ToRoman(n)
+		if err != nil {
+			fmt.Fprintf(errW, "toolbox roman: %v\n", err)
+			return exitUsage
+		}
+		fmt.Fprintln(out, result)
+		return exitOK
+	}
+
+	result, err := roman.FromRoman(args[0])
+	if err != nil {
+		fmt.Fprintf(errW, "toolbox roman: %v\n", err)
+		return exitUsage
+	}
+	fmt.Fprintln(out, result)
+	return exitOK
+}
+
+// runBase dispatches to the base-converter app: <value> <fromBase>
+// <toBase>.
+func runBase(args []string, out, errW io.Writer) int {
+	if len(args) != 3 {
+		fmt.Fprintln(errW, "toolbox base: expected <value> <from base> <to base>")
+		return exitUsage
+	}
+
+	fromBase, err := strconv.Atoi(args[1])
+	if err != nil {
+		fmt.Fprintf(errW, "toolbox base: invalid from base %q\n", args[1])
+		return exitUsage
+	}
+	toBase, err := strconv.Atoi(args[2])
+	if err != nil {
+		fmt.Fprintf(errW, "toolbox base: invalid to base %q\n", args[2])
+		return exitUsage
+	}
+
+	result, err := baseconverter.Convert(args[0], fromBase, toBase)
+	if err != nil {
+		fmt.Fprintf(errW, "toolbox base: %v\n", err)
+		return exitUsage
+	}
+	fmt.Fprintln(out, result)
+	return exitOK
+}
+
+// joinArgs re-joins args with spaces, since Run has already split the
+// convert expression into separate os.Args entries.
+func joinArgs(args []string) string {
+	expr := ""
+	for i, a := range args {
+		if i > 0 {
+			expr += " "
+		}
+		expr += a
+	}
+	return expr
+}
@@ -0,0 +1,63 @@
+package main
+
+import (
+	"bytes"
+	"strings"
+	"testing"
+)
+
+func TestRunConvert(t *testing.T) {
+	var out, errW bytes.Buffer
+	code := Run([]string{"convert", "5", "m", "to", "ft"}, &out, &errW)
+	if code != exitOK {
+		t.Fatalf("Run(convert) = %d, want %d; stderr: %s", code, exitOK, errW.String())
+	}
+	if !strings.Contains(out.String(), "16.4042") {
+		t.Errorf("Run(convert) output = %q, want it to contain 16.4042", out.String())
+	}
+}
+
+func TestRunRoman(t *testing.T) {
+	var out, errW bytes.Buffer
+	if code := Run([]string{"roman", "42"}, &out, &errW); code != exitOK {
+		t.Fatalf("Run(roman, 42) = %d, want %d; stderr: %s", code, exitOK, errW.String())
+	}
+	if strings.TrimSpace(out.String()) != "XLII" {
+		t.Errorf("Run(roman, 42) output = %q, want %q", out.String(), "XLII")
+	}
+}
+
+func TestRunBase(t *testing.T) {
+	var out, errW bytes.Buffer
+	if code := Run([]string{"base", "255", "10", "16"}, &out, &errW); code != exitOK {
+		t.Fatalf("Run(base) = %d, want %d; stderr: %s", code, exitOK, errW.String())
+	}
+	if strings.TrimSpace(out.String()) != "ff" {
+		t.Errorf("Run(base) output = %q, want %q", out.String(), "ff")
+	}
+}
+
+func TestRunUnknownSubcommand(t *testing.T) {
+	var out, errW bytes.Buffer
+	code := Run([]string{"bogus"}, &out, &errW)
+	if code != exitUsage {
+		t.Fatalf("Run(bogus) = %d, want %d", code, exitUsage)
+	}
+	if !strings.Contains(errW.String(), "unknown subcommand") {
+		t.Errorf("Run(bogus) stderr = %q, want it to mention the unknown subcommand", errW.String())
+	}
+	if !strings.Contains(errW.String(), "usage:") {
+		t.Errorf("Run(bogus) stderr = %q, want it to include usage", errW.String())
+	}
+}
+
+func TestRunBareHelp(t *testing.T) {
+	var out, errW bytes.Buffer
+	code := Run(nil, &out, &errW)
+	if code != exitOK {
+		t.Fatalf("Run(nil) = %d, want %d", code, exitOK)
+	}
+	if !strings.Contains(out.String(), "usage:") {
+		t.Errorf("Run(nil) output = %q, want it to include usage", out.String())
+	}
+}